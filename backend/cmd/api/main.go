@@ -15,6 +15,8 @@ import (
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/config"
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/db"
 	platformhttp "github.com/your-org/ai-tools-atlas-backend/internal/platform/http"
+	"github.com/your-org/ai-tools-atlas-backend/internal/reviews"
+	"github.com/your-org/ai-tools-atlas-backend/internal/trending"
 )
 
 func main() {
@@ -40,11 +42,30 @@ func main() {
 
 	log.Println("Database connection established")
 
-	// Initialize auth service
-	authService := auth.NewService()
+	// Initialize auth service; the repository lets the auth middleware reject suspended users
+	authService := auth.NewServiceWithRepo(auth.NewRepository(database))
+
+	// Initialize trending service and start the background recompute job
+	trendingService := trending.NewService(trending.NewRepository(database), trending.Settings{
+		HalfLife: cfg.Trending.HalfLife,
+		Window:   cfg.Trending.Window,
+		Weights: trending.Weights{
+			Bookmark: cfg.Trending.WeightBookmark,
+			Review:   cfg.Trending.WeightReview,
+			View:     cfg.Trending.WeightView,
+			ClickOut: cfg.Trending.WeightClickOut,
+		},
+	})
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	trending.StartJob(jobCtx, trendingService, cfg.Trending.Interval)
+
+	// Periodically refresh the catalog mean behind Bayesian ratings
+	ratings := reviews.NewRepositoryWithRatingWeight(database, cfg.RatingConfidenceWeight)
+	reviews.StartRatingRefreshJob(jobCtx, ratings, cfg.RatingRefreshInterval)
 
 	// Setup router
-	router := platformhttp.SetupRouter(cfg, database, authService)
+	router := platformhttp.SetupRouter(cfg, database, authService, trendingService)
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -69,6 +90,7 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopJobs()
 
 	// Graceful shutdown with 5 second timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
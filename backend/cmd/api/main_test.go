@@ -10,6 +10,7 @@ import (
 	"github.com/your-org/ai-tools-atlas-backend/internal/auth"
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/config"
 	platformhttp "github.com/your-org/ai-tools-atlas-backend/internal/platform/http"
+	"github.com/your-org/ai-tools-atlas-backend/internal/trending"
 )
 
 func TestIntegration_HealthCheckEndpoint(t *testing.T) {
@@ -33,7 +34,8 @@ func TestIntegration_HealthCheckEndpoint(t *testing.T) {
 
 	// Setup router (with nil database for health check test - handlers that need DB won't work)
 	authService := auth.NewService()
-	router := platformhttp.SetupRouter(cfg, nil, authService)
+	trendingService := trending.NewService(trending.NewRepository(nil), trending.Settings{})
+	router := platformhttp.SetupRouter(cfg, nil, authService, trendingService)
 
 	// Create test server
 	ts := httptest.NewServer(router)
@@ -1,8 +1,11 @@
 package analytics
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
@@ -25,12 +28,13 @@ func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
 		analytics.GET("/overview", h.GetOverview)
 		analytics.GET("/top-tools", h.GetTopTools)
 		analytics.GET("/top-categories", h.GetTopCategories)
+		analytics.GET("/timeseries", h.GetTimeseries)
 	}
 }
 
 // GetOverview handles GET /api/v1/admin/analytics/overview
 func (h *Handler) GetOverview(c *gin.Context) {
-	stats, err := h.service.GetOverviewStats()
+	stats, err := h.service.GetOverviewStats(c.Request.Context())
 	if err != nil {
 		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch analytics", nil)
 		return
@@ -61,3 +65,55 @@ func (h *Handler) GetTopCategories(c *gin.Context) {
 	}
 	responses.Success(c, categories)
 }
+
+// GetTimeseries handles GET /api/v1/admin/analytics/timeseries
+func (h *Handler) GetTimeseries(c *gin.Context) {
+	from, okFrom := parseDate(c.Query("from"))
+	to, okTo := parseDate(c.Query("to"))
+	if !okFrom || !okTo {
+		responses.Error(c, http.StatusBadRequest, "INVALID_DATE", "from and to must be dates in YYYY-MM-DD format", nil)
+		return
+	}
+
+	series, err := h.service.GetTimeseries(TimeseriesQuery{
+		Metric:   c.Query("metric"),
+		Interval: c.Query("interval"),
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidMetric):
+			responses.Error(c, http.StatusBadRequest, "INVALID_METRIC", "metric must be one of: reviews, bookmarks, users, tools", nil)
+		case errors.Is(err, ErrInvalidInterval):
+			responses.Error(c, http.StatusBadRequest, "INVALID_INTERVAL", "interval must be one of: day, week", nil)
+		case errors.Is(err, ErrInvalidDateRange):
+			responses.Error(c, http.StatusBadRequest, "INVALID_DATE_RANGE", "from must not be after to", nil)
+		case errors.Is(err, ErrRangeTooLarge):
+			responses.Error(c, http.StatusBadRequest, "RANGE_TOO_LARGE", "Date range is too large for the selected interval", map[string]interface{}{
+				"max_buckets": maxTimeseriesBuckets,
+			})
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch timeseries", nil)
+		}
+		return
+	}
+
+	responses.List(c, series.Points, map[string]interface{}{
+		"metric":   series.Metric,
+		"interval": series.Interval,
+		"from":     series.From,
+		"to":       series.To,
+		"total":    series.Total,
+	})
+}
+
+// parseDate parses an optional YYYY-MM-DD query value; an empty value yields the zero time
+func parseDate(raw string) (time.Time, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, true
+	}
+	t, err := time.Parse(dateLayout, raw)
+	return t, err == nil
+}
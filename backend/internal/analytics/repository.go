@@ -1,6 +1,11 @@
 package analytics
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
 	"time"
 
 	"gorm.io/gorm"
@@ -17,6 +22,11 @@ type OverviewStats struct {
 	NewToolsMonth   int64 `json:"new_tools_month"`
 	NewReviewsWeek  int64 `json:"new_reviews_week"`
 	NewUsersWeek    int64 `json:"new_users_week"`
+
+	// Partial is set when some aggregates could not be computed; their names
+	// are listed in FailedMetrics and their counts are zero
+	Partial       bool     `json:"partial"`
+	FailedMetrics []string `json:"failed_metrics,omitempty"`
 }
 
 // TopTool represents a tool with its ranking metrics
@@ -38,13 +48,31 @@ type TopCategory struct {
 	ToolCount int64  `json:"tool_count"`
 }
 
+// BucketCount is the number of rows created within one time bucket
+type BucketCount struct {
+	Bucket time.Time
+	Count  int64
+}
+
+// timeseriesSources maps each timeseries metric to the table and filter it counts
+var timeseriesSources = map[string]struct {
+	table  string
+	filter string
+}{
+	MetricReviews:   {table: "reviews"},
+	MetricBookmarks: {table: "bookmarks"},
+	MetricUsers:     {table: "users"},
+	MetricTools:     {table: "tools", filter: "archived_at IS NULL"},
+}
+
 // Repository defines the interface for analytics data operations
 type Repository interface {
-	GetOverviewStats() (*OverviewStats, error)
+	GetOverviewStats(ctx context.Context) (*OverviewStats, error)
 	GetTopToolsByBookmarks(limit int) ([]TopTool, error)
 	GetTopToolsByRating(limit int) ([]TopTool, error)
 	GetTopToolsByReviews(limit int) ([]TopTool, error)
 	GetTopCategories(limit int) ([]TopCategory, error)
+	GetTimeseries(metric, interval string, from, to time.Time) ([]BucketCount, error)
 }
 
 // repository implements the Repository interface
@@ -57,26 +85,121 @@ func NewRepository(db *gorm.DB) Repository {
 	return &repository{db: db}
 }
 
-// GetOverviewStats returns aggregate statistics
-func (r *repository) GetOverviewStats() (*OverviewStats, error) {
-	stats := &OverviewStats{}
+// overviewRow holds the counts returned by one overview query
+type overviewRow struct {
+	Total int64
+	Week  int64
+	Month int64
+}
+
+// overviewGroup is one round trip of the overview: a single query against one table
+// whose counts are copied into the stats
+type overviewGroup struct {
+	name  string
+	query string
+	apply func(row overviewRow, stats *OverviewStats)
+}
+
+// overviewGroups are the aggregate queries that make up the overview
+var overviewGroups = []overviewGroup{
+	{
+		name: "tools",
+		query: `SELECT COUNT(*) AS total,
+				COUNT(*) FILTER (WHERE created_at >= @week) AS week,
+				COUNT(*) FILTER (WHERE created_at >= @month) AS month
+				FROM tools WHERE archived_at IS NULL`,
+		apply: func(row overviewRow, stats *OverviewStats) {
+			stats.TotalTools, stats.NewToolsWeek, stats.NewToolsMonth = row.Total, row.Week, row.Month
+		},
+	},
+	{
+		name:  "categories",
+		query: `SELECT COUNT(*) AS total FROM categories`,
+		apply: func(row overviewRow, stats *OverviewStats) {
+			stats.TotalCategories = row.Total
+		},
+	},
+	{
+		name: "reviews",
+		query: `SELECT COUNT(*) AS total,
+				COUNT(*) FILTER (WHERE created_at >= @week) AS week
+				FROM reviews`,
+		apply: func(row overviewRow, stats *OverviewStats) {
+			stats.TotalReviews, stats.NewReviewsWeek = row.Total, row.Week
+		},
+	},
+	{
+		name:  "bookmarks",
+		query: `SELECT COUNT(*) AS total FROM bookmarks`,
+		apply: func(row overviewRow, stats *OverviewStats) {
+			stats.TotalBookmarks = row.Total
+		},
+	},
+	{
+		name: "users",
+		query: `SELECT COUNT(*) AS total,
+				COUNT(*) FILTER (WHERE created_at >= @week) AS week
+				FROM users`,
+		apply: func(row overviewRow, stats *OverviewStats) {
+			stats.TotalUsers, stats.NewUsersWeek = row.Total, row.Week
+		},
+	},
+}
+
+// GetOverviewStats returns aggregate statistics, querying each table concurrently.
+// Groups that fail are listed in FailedMetrics and left at zero; an error is returned
+// only if the context is cancelled or every group fails.
+func (r *repository) GetOverviewStats(ctx context.Context) (*OverviewStats, error) {
+	now := time.Now()
+	params := map[string]interface{}{
+		"week":  now.AddDate(0, 0, -7),
+		"month": now.AddDate(0, -1, 0),
+	}
+
+	return collectOverview(ctx, func(ctx context.Context, g overviewGroup) (overviewRow, error) {
+		var row overviewRow
+		err := r.db.WithContext(ctx).Raw(g.query, params).Scan(&row).Error
+		return row, err
+	})
+}
+
+// collectOverview runs every overview group concurrently and merges the results
+func collectOverview(ctx context.Context, run func(ctx context.Context, g overviewGroup) (overviewRow, error)) (*OverviewStats, error) {
+	rows := make([]overviewRow, len(overviewGroups))
+	errs := make([]error, len(overviewGroups))
 
-	// Total counts
-	r.db.Table("tools").Where("archived_at IS NULL").Count(&stats.TotalTools)
-	r.db.Table("categories").Count(&stats.TotalCategories)
-	r.db.Table("reviews").Count(&stats.TotalReviews)
-	r.db.Table("bookmarks").Count(&stats.TotalBookmarks)
-	r.db.Table("users").Count(&stats.TotalUsers)
+	var wg sync.WaitGroup
+	for i, g := range overviewGroups {
+		wg.Add(1)
+		go func(i int, g overviewGroup) {
+			defer wg.Done()
+			rows[i], errs[i] = run(ctx, g)
+		}(i, g)
+	}
+	wg.Wait()
 
-	// New this week
-	weekAgo := time.Now().AddDate(0, 0, -7)
-	monthAgo := time.Now().AddDate(0, -1, 0)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-	r.db.Table("tools").Where("created_at >= ? AND archived_at IS NULL", weekAgo).Count(&stats.NewToolsWeek)
-	r.db.Table("tools").Where("created_at >= ? AND archived_at IS NULL", monthAgo).Count(&stats.NewToolsMonth)
-	r.db.Table("reviews").Where("created_at >= ?", weekAgo).Count(&stats.NewReviewsWeek)
-	r.db.Table("users").Where("created_at >= ?", weekAgo).Count(&stats.NewUsersWeek)
+	stats := &OverviewStats{}
+	var failed []error
+	for i, g := range overviewGroups {
+		if errs[i] != nil {
+			stats.FailedMetrics = append(stats.FailedMetrics, g.name)
+			failed = append(failed, fmt.Errorf("%s: %w", g.name, errs[i]))
+			continue
+		}
+		g.apply(rows[i], stats)
+	}
 
+	if len(failed) == len(overviewGroups) {
+		return nil, errors.Join(failed...)
+	}
+	if len(failed) > 0 {
+		stats.Partial = true
+		log.Printf("Analytics overview partially failed: %v", errors.Join(failed...))
+	}
 	return stats, nil
 }
 
@@ -93,14 +216,14 @@ func (r *repository) GetTopToolsByBookmarks(limit int) ([]TopTool, error) {
 	return tools, err
 }
 
-// GetTopToolsByRating returns top tools by rating
+// GetTopToolsByRating returns top tools by Bayesian-adjusted rating
 func (r *repository) GetTopToolsByRating(limit int) ([]TopTool, error) {
 	var tools []TopTool
 	err := r.db.Raw(`
 		SELECT t.id, t.slug, t.name, t.logo_url, t.bookmark_count, t.review_count, t.avg_rating_overall as avg_rating
 		FROM tools t
 		WHERE t.archived_at IS NULL AND t.review_count >= 1
-		ORDER BY t.avg_rating_overall DESC, t.review_count DESC
+		ORDER BY t.bayesian_rating DESC, t.review_count DESC
 		LIMIT ?
 	`, limit).Scan(&tools).Error
 	return tools, err
@@ -132,3 +255,23 @@ func (r *repository) GetTopCategories(limit int) ([]TopCategory, error) {
 	`, limit).Scan(&categories).Error
 	return categories, err
 }
+
+// GetTimeseries counts rows created in [from, to) grouped by day or week.
+// Buckets without rows are omitted; the service fills the gaps.
+func (r *repository) GetTimeseries(metric, interval string, from, to time.Time) ([]BucketCount, error) {
+	source, ok := timeseriesSources[metric]
+	if !ok {
+		return nil, ErrInvalidMetric
+	}
+
+	query := r.db.Table(source.table).
+		Select("date_trunc(?, created_at) AS bucket, COUNT(*) AS count", interval).
+		Where("created_at >= ? AND created_at < ?", from, to)
+	if source.filter != "" {
+		query = query.Where(source.filter)
+	}
+
+	var counts []BucketCount
+	err := query.Group("bucket").Order("bucket").Scan(&counts).Error
+	return counts, err
+}
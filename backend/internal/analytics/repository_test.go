@@ -0,0 +1,67 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errQueryFailed = errors.New("query failed")
+
+// runOverviewGroups returns a fixed row for every group, failing the named ones
+func runOverviewGroups(failing ...string) func(ctx context.Context, g overviewGroup) (overviewRow, error) {
+	return func(ctx context.Context, g overviewGroup) (overviewRow, error) {
+		for _, name := range failing {
+			if g.name == name {
+				return overviewRow{}, errQueryFailed
+			}
+		}
+		return overviewRow{Total: 10, Week: 2, Month: 5}, nil
+	}
+}
+
+func TestCollectOverview(t *testing.T) {
+	t.Run("merges every group", func(t *testing.T) {
+		stats, err := collectOverview(context.Background(), runOverviewGroups())
+
+		assert.NoError(t, err)
+		assert.False(t, stats.Partial)
+		assert.Empty(t, stats.FailedMetrics)
+		assert.Equal(t, int64(10), stats.TotalTools)
+		assert.Equal(t, int64(5), stats.NewToolsMonth)
+		assert.Equal(t, int64(10), stats.TotalCategories)
+		assert.Equal(t, int64(2), stats.NewReviewsWeek)
+		assert.Equal(t, int64(10), stats.TotalBookmarks)
+		assert.Equal(t, int64(2), stats.NewUsersWeek)
+	})
+
+	t.Run("returns partial stats when some groups fail", func(t *testing.T) {
+		stats, err := collectOverview(context.Background(), runOverviewGroups("reviews", "users"))
+
+		assert.NoError(t, err)
+		assert.True(t, stats.Partial)
+		assert.Equal(t, []string{"reviews", "users"}, stats.FailedMetrics)
+		assert.Equal(t, int64(0), stats.TotalReviews)
+		assert.Equal(t, int64(0), stats.TotalUsers)
+		assert.Equal(t, int64(10), stats.TotalTools)
+	})
+
+	t.Run("returns an error when every group fails", func(t *testing.T) {
+		stats, err := collectOverview(context.Background(), runOverviewGroups("tools", "categories", "reviews", "bookmarks", "users"))
+
+		assert.ErrorIs(t, err, errQueryFailed)
+		assert.Nil(t, stats)
+	})
+
+	t.Run("returns an error when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		stats, err := collectOverview(ctx, runOverviewGroups())
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, stats)
+	})
+}
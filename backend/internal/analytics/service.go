@@ -1,5 +1,64 @@
 package analytics
 
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Timeseries metrics
+const (
+	MetricReviews   = "reviews"
+	MetricBookmarks = "bookmarks"
+	MetricUsers     = "users"
+	MetricTools     = "tools"
+)
+
+// Timeseries bucket intervals
+const (
+	IntervalDay  = "day"
+	IntervalWeek = "week"
+)
+
+// maxTimeseriesBuckets bounds the number of buckets a single request may return
+const maxTimeseriesBuckets = 366
+
+// dateLayout is the format of timeseries dates in requests and responses
+const dateLayout = "2006-01-02"
+
+// Error constants
+var (
+	ErrInvalidMetric    = errors.New("invalid metric")
+	ErrInvalidInterval  = errors.New("invalid interval")
+	ErrInvalidDateRange = errors.New("from must not be after to")
+	ErrRangeTooLarge    = errors.New("date range has too many buckets")
+)
+
+// TimeseriesQuery selects a metric, bucket interval and inclusive date range.
+// Zero dates default to a recent window ending today.
+type TimeseriesQuery struct {
+	Metric   string
+	Interval string
+	From     time.Time
+	To       time.Time
+}
+
+// TimeseriesPoint is the count for the bucket starting on Date
+type TimeseriesPoint struct {
+	Date  string `json:"date"`
+	Count int64  `json:"count"`
+}
+
+// Timeseries is a gap-filled series of bucket counts
+type Timeseries struct {
+	Metric   string            `json:"metric"`
+	Interval string            `json:"interval"`
+	From     string            `json:"from"`
+	To       string            `json:"to"`
+	Total    int64             `json:"total"`
+	Points   []TimeseriesPoint `json:"points"`
+}
+
 // TopToolsResponse contains different top tool lists
 type TopToolsResponse struct {
 	ByBookmarks []TopTool `json:"by_bookmarks"`
@@ -9,9 +68,10 @@ type TopToolsResponse struct {
 
 // Service defines the interface for analytics business logic
 type Service interface {
-	GetOverviewStats() (*OverviewStats, error)
+	GetOverviewStats(ctx context.Context) (*OverviewStats, error)
 	GetTopTools(limit int) (*TopToolsResponse, error)
 	GetTopCategories(limit int) ([]TopCategory, error)
+	GetTimeseries(query TimeseriesQuery) (*Timeseries, error)
 }
 
 // service implements the Service interface
@@ -25,8 +85,8 @@ func NewService(repo Repository) Service {
 }
 
 // GetOverviewStats returns aggregate statistics
-func (s *service) GetOverviewStats() (*OverviewStats, error) {
-	return s.repo.GetOverviewStats()
+func (s *service) GetOverviewStats(ctx context.Context) (*OverviewStats, error) {
+	return s.repo.GetOverviewStats(ctx)
 }
 
 // GetTopTools returns top tools by different metrics
@@ -70,3 +130,93 @@ func (s *service) GetTopCategories(limit int) ([]TopCategory, error) {
 	}
 	return s.repo.GetTopCategories(limit)
 }
+
+// GetTimeseries returns per-bucket counts for a metric, with a zero count for every empty bucket
+func (s *service) GetTimeseries(query TimeseriesQuery) (*Timeseries, error) {
+	if _, ok := timeseriesSources[query.Metric]; !ok {
+		return nil, ErrInvalidMetric
+	}
+	if query.Interval == "" {
+		query.Interval = IntervalDay
+	}
+	if query.Interval != IntervalDay && query.Interval != IntervalWeek {
+		return nil, ErrInvalidInterval
+	}
+
+	to := query.To
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	to = bucketStart(to, IntervalDay)
+
+	from := query.From
+	if from.IsZero() {
+		from = defaultFrom(to, query.Interval)
+	}
+	from = bucketStart(from, query.Interval)
+
+	if from.After(to) {
+		return nil, ErrInvalidDateRange
+	}
+
+	var buckets []time.Time
+	for b := from; !b.After(to); b = nextBucket(b, query.Interval) {
+		if len(buckets) == maxTimeseriesBuckets {
+			return nil, ErrRangeTooLarge
+		}
+		buckets = append(buckets, b)
+	}
+
+	// The range is inclusive of the whole "to" day
+	counts, err := s.repo.GetTimeseries(query.Metric, query.Interval, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		byDate[c.Bucket.Format(dateLayout)] = c.Count
+	}
+
+	series := &Timeseries{
+		Metric:   query.Metric,
+		Interval: query.Interval,
+		From:     from.Format(dateLayout),
+		To:       to.Format(dateLayout),
+		Points:   make([]TimeseriesPoint, len(buckets)),
+	}
+	for i, b := range buckets {
+		date := b.Format(dateLayout)
+		series.Points[i] = TimeseriesPoint{Date: date, Count: byDate[date]}
+		series.Total += byDate[date]
+	}
+
+	return series, nil
+}
+
+// bucketStart truncates t to the start of its UTC day or ISO week (Monday), matching Postgres date_trunc
+func bucketStart(t time.Time, interval string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if interval == IntervalWeek {
+		offset := (int(day.Weekday()) + 6) % 7
+		day = day.AddDate(0, 0, -offset)
+	}
+	return day
+}
+
+// nextBucket returns the start of the bucket after b
+func nextBucket(b time.Time, interval string) time.Time {
+	if interval == IntervalWeek {
+		return b.AddDate(0, 0, 7)
+	}
+	return b.AddDate(0, 0, 1)
+}
+
+// defaultFrom returns the start of the default window ending at to: 30 days or 12 weeks
+func defaultFrom(to time.Time, interval string) time.Time {
+	if interval == IntervalWeek {
+		return to.AddDate(0, 0, -7*11)
+	}
+	return to.AddDate(0, 0, -29)
+}
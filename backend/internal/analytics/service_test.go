@@ -0,0 +1,198 @@
+package analytics_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/analytics"
+)
+
+// MockRepository is a mock implementation of analytics.Repository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetOverviewStats(ctx context.Context) (*analytics.OverviewStats, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*analytics.OverviewStats), args.Error(1)
+}
+
+func (m *MockRepository) GetTopToolsByBookmarks(limit int) ([]analytics.TopTool, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]analytics.TopTool), args.Error(1)
+}
+
+func (m *MockRepository) GetTopToolsByRating(limit int) ([]analytics.TopTool, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]analytics.TopTool), args.Error(1)
+}
+
+func (m *MockRepository) GetTopToolsByReviews(limit int) ([]analytics.TopTool, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]analytics.TopTool), args.Error(1)
+}
+
+func (m *MockRepository) GetTopCategories(limit int) ([]analytics.TopCategory, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]analytics.TopCategory), args.Error(1)
+}
+
+func (m *MockRepository) GetTimeseries(metric, interval string, from, to time.Time) ([]analytics.BucketCount, error) {
+	args := m.Called(metric, interval, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]analytics.BucketCount), args.Error(1)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestServiceGetTimeseries(t *testing.T) {
+	t.Run("fills empty days with zero counts", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		// The whole "to" day is included, so the repository range ends the day after
+		mockRepo.On("GetTimeseries", analytics.MetricReviews, analytics.IntervalDay, date(2024, 3, 1), date(2024, 3, 5)).
+			Return([]analytics.BucketCount{
+				{Bucket: date(2024, 3, 1), Count: 2},
+				{Bucket: date(2024, 3, 3), Count: 5},
+			}, nil)
+
+		service := analytics.NewService(mockRepo)
+		series, err := service.GetTimeseries(analytics.TimeseriesQuery{
+			Metric: analytics.MetricReviews,
+			From:   date(2024, 3, 1),
+			To:     time.Date(2024, 3, 4, 18, 30, 0, 0, time.UTC),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, analytics.IntervalDay, series.Interval)
+		assert.Equal(t, "2024-03-01", series.From)
+		assert.Equal(t, "2024-03-04", series.To)
+		assert.Equal(t, []analytics.TimeseriesPoint{
+			{Date: "2024-03-01", Count: 2},
+			{Date: "2024-03-02", Count: 0},
+			{Date: "2024-03-03", Count: 5},
+			{Date: "2024-03-04", Count: 0},
+		}, series.Points)
+		assert.Equal(t, int64(7), series.Total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("aligns weekly buckets to ISO weeks", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetTimeseries", analytics.MetricUsers, analytics.IntervalWeek, date(2024, 3, 4), date(2024, 3, 21)).
+			Return([]analytics.BucketCount{{Bucket: date(2024, 3, 11), Count: 4}}, nil)
+
+		service := analytics.NewService(mockRepo)
+		series, err := service.GetTimeseries(analytics.TimeseriesQuery{
+			Metric:   analytics.MetricUsers,
+			Interval: analytics.IntervalWeek,
+			From:     date(2024, 3, 6), // Wednesday
+			To:       date(2024, 3, 20),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2024-03-04", series.From)
+		assert.Equal(t, []analytics.TimeseriesPoint{
+			{Date: "2024-03-04", Count: 0},
+			{Date: "2024-03-11", Count: 4},
+			{Date: "2024-03-18", Count: 0},
+		}, series.Points)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("starts weeks on Monday", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			from     time.Time
+			expected string
+		}{
+			{"monday", date(2024, 3, 4), "2024-03-04"},
+			{"sunday", date(2024, 3, 10), "2024-03-04"},
+			{"across a year boundary", date(2021, 1, 1), "2020-12-28"},
+			{"converted to UTC first", time.Date(2024, 3, 4, 2, 0, 0, 0, time.FixedZone("UTC+5", 5*60*60)), "2024-02-26"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo := new(MockRepository)
+				mockRepo.On("GetTimeseries", analytics.MetricTools, analytics.IntervalWeek, mock.Anything, mock.Anything).
+					Return([]analytics.BucketCount{}, nil)
+
+				service := analytics.NewService(mockRepo)
+				series, err := service.GetTimeseries(analytics.TimeseriesQuery{
+					Metric:   analytics.MetricTools,
+					Interval: analytics.IntervalWeek,
+					From:     tt.from,
+					To:       tt.from,
+				})
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, series.From)
+				assert.Len(t, series.Points, 1)
+			})
+		}
+	})
+
+	t.Run("allows up to the bucket limit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetTimeseries", analytics.MetricBookmarks, analytics.IntervalDay, date(2023, 1, 1), date(2024, 1, 2)).
+			Return([]analytics.BucketCount{}, nil)
+
+		service := analytics.NewService(mockRepo)
+		series, err := service.GetTimeseries(analytics.TimeseriesQuery{
+			Metric: analytics.MetricBookmarks,
+			From:   date(2023, 1, 1),
+			To:     date(2024, 1, 1),
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, series.Points, 366)
+	})
+
+	t.Run("rejects ranges over the bucket limit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := analytics.NewService(mockRepo)
+		_, err := service.GetTimeseries(analytics.TimeseriesQuery{
+			Metric: analytics.MetricBookmarks,
+			From:   date(2023, 1, 1),
+			To:     date(2024, 1, 2),
+		})
+
+		assert.ErrorIs(t, err, analytics.ErrRangeTooLarge)
+		mockRepo.AssertNotCalled(t, "GetTimeseries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects from after to", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := analytics.NewService(mockRepo)
+		_, err := service.GetTimeseries(analytics.TimeseriesQuery{
+			Metric: analytics.MetricReviews,
+			From:   date(2024, 3, 5),
+			To:     date(2024, 3, 4),
+		})
+
+		assert.ErrorIs(t, err, analytics.ErrInvalidDateRange)
+		mockRepo.AssertNotCalled(t, "GetTimeseries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects unknown metric and interval", func(t *testing.T) {
+		service := analytics.NewService(new(MockRepository))
+
+		_, err := service.GetTimeseries(analytics.TimeseriesQuery{Metric: "sessions"})
+		assert.ErrorIs(t, err, analytics.ErrInvalidMetric)
+
+		_, err = service.GetTimeseries(analytics.TimeseriesQuery{Metric: analytics.MetricReviews, Interval: "month"})
+		assert.ErrorIs(t, err, analytics.ErrInvalidInterval)
+	})
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ForgotPassword handles password reset requests. It responds with 202 whatever happens to the
+// email so the endpoint does not reveal which email addresses have accounts; only the per-IP
+// rate limit is reported.
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	var input ForgotPasswordInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	if err := h.service.ForgotPassword(input.Email, sessionMeta(c)); err != nil {
+		var throttled *AccountEmailThrottledError
+		if errors.As(err, &throttled) {
+			writeEmailThrottled(c, throttled)
+			return
+		}
+		log.Printf("Failed to handle password reset request: %v", err)
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"data": gin.H{
+			"message": "If an account exists for this email, a password reset link has been sent",
+		},
+	})
+}
+
+// ResetPassword handles choosing a new password with a reset token
+func (h *Handler) ResetPassword(c *gin.Context) {
+	var input ResetPasswordInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	if err := h.service.ResetPassword(input); err != nil {
+		switch {
+		case errors.Is(err, ErrPasswordTooShort):
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error": gin.H{
+					"code":    "PASSWORD_TOO_SHORT",
+					"message": err.Error(),
+				},
+			})
+		case errors.Is(err, ErrInvalidAccountToken):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "INVALID_TOKEN",
+					"message": "This reset link is invalid or has expired",
+				},
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": gin.H{
+					"code":    "INTERNAL_ERROR",
+					"message": "Failed to reset password",
+				},
+			})
+		}
+		return
+	}
+
+	// Every session was revoked, including this browser's
+	h.clearAuthCookie(c)
+	c.Status(http.StatusNoContent)
+}
+
+// VerifyEmail handles confirming an email address with a verification token
+func (h *Handler) VerifyEmail(c *gin.Context) {
+	var input VerifyEmailInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body",
+			},
+		})
+		return
+	}
+
+	if err := h.service.VerifyEmail(input.Token); err != nil {
+		if errors.Is(err, ErrInvalidAccountToken) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "INVALID_TOKEN",
+					"message": "This verification link is invalid or has expired",
+				},
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to verify email",
+			},
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ResendVerification sends the current user a new email verification link
+func (h *Handler) ResendVerification(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.ResendVerificationEmail(userID); err != nil {
+		var throttled *AccountEmailThrottledError
+		if errors.As(err, &throttled) {
+			writeEmailThrottled(c, throttled)
+			return
+		}
+		if errors.Is(err, ErrEmailAlreadyVerified) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": gin.H{
+					"code":    "EMAIL_ALREADY_VERIFIED",
+					"message": "Email is already verified",
+				},
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to send verification email",
+			},
+		})
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// writeEmailThrottled responds with 429 and a Retry-After header for rate limited account emails
+func writeEmailThrottled(c *gin.Context, throttled *AccountEmailThrottledError) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"error": gin.H{
+			"code":    "TOO_MANY_REQUESTS",
+			"message": "Too many emails requested, please try again later",
+		},
+	})
+}
@@ -0,0 +1,241 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/mail"
+)
+
+var (
+	ErrAccountTokenNotFound = errors.New("account token not found")
+	ErrInvalidAccountToken  = errors.New("token is invalid, expired or already used")
+	ErrEmailAlreadyVerified = errors.New("email is already verified")
+	ErrMailerNotConfigured  = errors.New("mailer is not configured")
+)
+
+// Account token purposes, matching the account_tokens.purpose CHECK constraint
+const (
+	purposePasswordReset     = "password_reset"
+	purposeEmailVerification = "email_verification"
+)
+
+const (
+	passwordResetTokenDuration     = time.Hour
+	emailVerificationTokenDuration = 48 * time.Hour
+)
+
+const passwordResetEmail = `Hi %s,
+
+Someone asked to reset the password for your AI Tools Atlas account.
+If it was you, choose a new password here within the next hour:
+
+%s
+
+If you didn't ask for this, you can ignore this email; your password won't change.
+`
+
+const emailVerificationEmail = `Hi %s,
+
+Please confirm your email address for AI Tools Atlas:
+
+%s
+
+The link expires in 48 hours.
+`
+
+// ForgotPasswordInput contains the fields for requesting a password reset
+type ForgotPasswordInput struct {
+	Email string `json:"email" binding:"required"`
+}
+
+// ResetPasswordInput contains the fields for choosing a new password
+type ResetPasswordInput struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// VerifyEmailInput contains the token from an email verification link
+type VerifyEmailInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// ForgotPassword emails a password reset link if the address belongs to an active account.
+// The account lookup and the email happen in the background, so the call takes as long for
+// unknown addresses as for real ones and callers cannot use it to discover accounts.
+// Requests are rate limited per client IP.
+func (s *Service) ForgotPassword(email string, meta SessionMeta) error {
+	if s.mailer == nil {
+		return ErrMailerNotConfigured
+	}
+	if err := s.emailLimits.allowIP(meta.IPAddress); err != nil {
+		return err
+	}
+
+	email = strings.ToLower(strings.TrimSpace(email))
+	s.background(func() {
+		if err := s.sendPasswordResetEmail(email); err != nil {
+			log.Printf("Failed to send password reset email: %v", err)
+		}
+	})
+	return nil
+}
+
+// sendPasswordResetEmail issues a reset token and emails the link if email belongs to an active account
+func (s *Service) sendPasswordResetEmail(email string) error {
+	user, err := s.repo.GetByEmail(email)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+	if user.SuspendedAt != nil {
+		return nil
+	}
+	if err := s.emailLimits.allowAccount(user.ID); err != nil {
+		return fmt.Errorf("user %d: %w", user.ID, err)
+	}
+
+	token, err := s.issueAccountToken(user.ID, purposePasswordReset, passwordResetTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(mail.Message{
+		To:      user.Email,
+		Subject: "Reset your AI Tools Atlas password",
+		Body:    fmt.Sprintf(passwordResetEmail, greetingName(user), s.link("/reset-password", token)),
+	})
+}
+
+// ResetPassword sets a new password using a reset token and signs the user out everywhere
+func (s *Service) ResetPassword(input ResetPasswordInput) error {
+	if len(input.Password) < 8 {
+		return ErrPasswordTooShort
+	}
+	if input.Token == "" {
+		return ErrInvalidAccountToken
+	}
+
+	hashedPassword, err := s.HashPassword(input.Password)
+	if err != nil {
+		return err
+	}
+
+	// Using the token, changing the password and signing out happen together or not at all
+	if err := s.repo.ResetPassword(hashToken(input.Token), hashedPassword); err != nil {
+		if errors.Is(err, ErrAccountTokenNotFound) {
+			return ErrInvalidAccountToken
+		}
+		return err
+	}
+	return nil
+}
+
+// VerifyEmail confirms a user's email address using a verification token
+func (s *Service) VerifyEmail(rawToken string) error {
+	token, err := s.consumeAccountToken(purposeEmailVerification, rawToken)
+	if err != nil {
+		return err
+	}
+	return s.repo.MarkEmailVerified(token.UserID, time.Now())
+}
+
+// ResendVerificationEmail sends a new verification link, replacing any earlier one.
+// Requests are rate limited per account.
+func (s *Service) ResendVerificationEmail(userID uint) error {
+	if s.mailer == nil {
+		return ErrMailerNotConfigured
+	}
+
+	user, err := s.repo.GetByID(userID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerifiedAt != nil {
+		return ErrEmailAlreadyVerified
+	}
+	if err := s.emailLimits.allowAccount(user.ID); err != nil {
+		return err
+	}
+
+	return s.sendVerificationEmail(user)
+}
+
+// sendVerificationEmail issues a verification token and emails the link to the user
+func (s *Service) sendVerificationEmail(user *domain.User) error {
+	token, err := s.issueAccountToken(user.ID, purposeEmailVerification, emailVerificationTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(mail.Message{
+		To:      user.Email,
+		Subject: "Confirm your email address",
+		Body:    fmt.Sprintf(emailVerificationEmail, greetingName(user), s.link("/verify-email", token)),
+	})
+}
+
+// issueAccountToken stores a new token for the purpose, invalidating earlier unused ones,
+// and returns the raw token to put in the emailed link
+func (s *Service) issueAccountToken(userID uint, purpose string, lifetime time.Duration) (string, error) {
+	if err := s.repo.InvalidateAccountTokens(userID, purpose); err != nil {
+		return "", err
+	}
+
+	raw, err := generateSecureToken()
+	if err != nil {
+		return "", err
+	}
+
+	token := &domain.AccountToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hashToken(raw),
+		ExpiresAt: time.Now().Add(lifetime),
+	}
+	if err := s.repo.CreateAccountToken(token); err != nil {
+		return "", err
+	}
+
+	return raw, nil
+}
+
+// consumeAccountToken uses up a token, mapping unknown, expired and used tokens to ErrInvalidAccountToken
+func (s *Service) consumeAccountToken(purpose, rawToken string) (*domain.AccountToken, error) {
+	if rawToken == "" {
+		return nil, ErrInvalidAccountToken
+	}
+
+	token, err := s.repo.ConsumeAccountToken(purpose, hashToken(rawToken))
+	if err != nil {
+		if errors.Is(err, ErrAccountTokenNotFound) {
+			return nil, ErrInvalidAccountToken
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+// link builds a frontend URL carrying a token
+func (s *Service) link(path, token string) string {
+	return fmt.Sprintf("%s%s?token=%s", s.appURL, path, token)
+}
+
+// runInBackground runs work in a new goroutine
+func runInBackground(work func()) {
+	go work()
+}
+
+// greetingName is the name used to address a user in emails
+func greetingName(user *domain.User) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	return "there"
+}
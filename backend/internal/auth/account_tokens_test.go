@@ -0,0 +1,322 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/mail"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MockMailer is a mock implementation of mail.Mailer
+type MockMailer struct {
+	mock.Mock
+}
+
+func (m *MockMailer) Send(msg mail.Message) error {
+	args := m.Called(msg)
+	return args.Error(0)
+}
+
+// inline makes a service run background work before returning, so tests can assert on it
+func inline(s *Service) *Service {
+	s.background = func(work func()) { work() }
+	return s
+}
+
+// tokenFromLink extracts the token query parameter from an email body
+func tokenFromLink(body string) string {
+	_, after, _ := strings.Cut(body, "?token=")
+	token, _, _ := strings.Cut(after, "\n")
+	return token
+}
+
+func TestService_ForgotPassword(t *testing.T) {
+	t.Run("emails reset link", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockMailer := new(MockMailer)
+		mockRepo.On("GetByEmail", "jane@example.com").Return(&domain.User{ID: 2, Email: "jane@example.com", DisplayName: "Jane"}, nil)
+		mockRepo.On("InvalidateAccountTokens", uint(2), "password_reset").Return(nil)
+
+		var stored *domain.AccountToken
+		mockRepo.On("CreateAccountToken", mock.AnythingOfType("*domain.AccountToken")).Run(func(args mock.Arguments) {
+			stored = args.Get(0).(*domain.AccountToken)
+		}).Return(nil)
+
+		var sent mail.Message
+		mockMailer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+			sent = args.Get(0).(mail.Message)
+		}).Return(nil)
+
+		service := inline(NewServiceWithMailer(mockRepo, mockMailer, "https://atlas.example.com"))
+		err := service.ForgotPassword(" Jane@Example.com ", SessionMeta{IPAddress: "203.0.113.7"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "jane@example.com", sent.To)
+		assert.Contains(t, sent.Body, "https://atlas.example.com/reset-password?token=")
+		// Only the hash of the emailed token is stored
+		assert.Equal(t, hashToken(tokenFromLink(sent.Body)), stored.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), stored.ExpiresAt, time.Minute)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown email succeeds without sending", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockMailer := new(MockMailer)
+		mockRepo.On("GetByEmail", "nobody@example.com").Return(nil, ErrUserNotFound)
+
+		service := inline(NewServiceWithMailer(mockRepo, mockMailer, "https://atlas.example.com"))
+		err := service.ForgotPassword("nobody@example.com", SessionMeta{})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockMailer.AssertNotCalled(t, "Send", mock.Anything)
+	})
+
+	t.Run("does not report mail failures to the caller", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockMailer := new(MockMailer)
+		mockRepo.On("GetByEmail", "jane@example.com").Return(&domain.User{ID: 2, Email: "jane@example.com"}, nil)
+		mockRepo.On("InvalidateAccountTokens", uint(2), "password_reset").Return(nil)
+		mockRepo.On("CreateAccountToken", mock.AnythingOfType("*domain.AccountToken")).Return(nil)
+		mockMailer.On("Send", mock.Anything).Return(errors.New("smtp unavailable"))
+
+		service := inline(NewServiceWithMailer(mockRepo, mockMailer, "https://atlas.example.com"))
+		err := service.ForgotPassword("jane@example.com", SessionMeta{})
+
+		assert.NoError(t, err)
+		mockMailer.AssertExpectations(t)
+	})
+
+	t.Run("throttles requests per client IP", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", mock.Anything).Return(nil, ErrUserNotFound)
+
+		service := inline(NewServiceWithMailer(mockRepo, new(MockMailer), "https://atlas.example.com"))
+		meta := SessionMeta{IPAddress: "203.0.113.7"}
+		for i := 0; i < defaultAccountEmailPolicy.PerIP; i++ {
+			assert.NoError(t, service.ForgotPassword("nobody@example.com", meta))
+		}
+
+		err := service.ForgotPassword("nobody@example.com", meta)
+
+		var throttled *AccountEmailThrottledError
+		assert.ErrorAs(t, err, &throttled)
+		assert.Greater(t, throttled.RetryAfter, time.Duration(0))
+		assert.NoError(t, service.ForgotPassword("nobody@example.com", SessionMeta{IPAddress: "198.51.100.2"}))
+	})
+
+	t.Run("stops emailing an account over its limit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockMailer := new(MockMailer)
+		mockRepo.On("GetByEmail", "jane@example.com").Return(&domain.User{ID: 2, Email: "jane@example.com"}, nil)
+		mockRepo.On("InvalidateAccountTokens", uint(2), "password_reset").Return(nil)
+		mockRepo.On("CreateAccountToken", mock.AnythingOfType("*domain.AccountToken")).Return(nil)
+		mockMailer.On("Send", mock.Anything).Return(nil)
+
+		service := inline(NewServiceWithMailer(mockRepo, mockMailer, "https://atlas.example.com"))
+		for i := 0; i <= defaultAccountEmailPolicy.PerAccount; i++ {
+			// Different IPs, so only the per-account limit applies
+			assert.NoError(t, service.ForgotPassword("jane@example.com", SessionMeta{IPAddress: fmt.Sprintf("203.0.113.%d", i)}))
+		}
+
+		mockMailer.AssertNumberOfCalls(t, "Send", defaultAccountEmailPolicy.PerAccount)
+	})
+}
+
+func TestService_ResetPassword(t *testing.T) {
+	t.Run("resets password with the hashed token", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("ResetPassword", hashToken("reset-token"), mock.MatchedBy(func(hash string) bool {
+			return bcrypt.CompareHashAndPassword([]byte(hash), []byte("new-password")) == nil
+		})).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		err := service.ResetPassword(ResetPasswordInput{Token: "reset-token", Password: "new-password"})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects used or expired token", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("ResetPassword", hashToken("used-token"), mock.Anything).Return(ErrAccountTokenNotFound)
+
+		service := NewServiceWithRepo(mockRepo)
+		err := service.ResetPassword(ResetPasswordInput{Token: "used-token", Password: "new-password"})
+
+		assert.ErrorIs(t, err, ErrInvalidAccountToken)
+	})
+
+	t.Run("rejects short password without using token", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := NewServiceWithRepo(mockRepo)
+		err := service.ResetPassword(ResetPasswordInput{Token: "reset-token", Password: "short"})
+
+		assert.ErrorIs(t, err, ErrPasswordTooShort)
+		mockRepo.AssertNotCalled(t, "ResetPassword", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_VerifyEmail(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockRepo.On("ConsumeAccountToken", "email_verification", hashToken("verify-token")).Return(&domain.AccountToken{ID: 1, UserID: 2}, nil)
+	mockRepo.On("MarkEmailVerified", uint(2), mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := NewServiceWithRepo(mockRepo)
+	err := service.VerifyEmail("verify-token")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_ResendVerificationEmail(t *testing.T) {
+	t.Run("rejects verified email", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		verifiedAt := time.Now()
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, EmailVerifiedAt: &verifiedAt}, nil)
+
+		service := NewServiceWithMailer(mockRepo, new(MockMailer), "https://atlas.example.com")
+		err := service.ResendVerificationEmail(2)
+
+		assert.ErrorIs(t, err, ErrEmailAlreadyVerified)
+	})
+
+	t.Run("throttles repeated requests", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockMailer := new(MockMailer)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Email: "jane@example.com"}, nil)
+		mockRepo.On("InvalidateAccountTokens", uint(2), "email_verification").Return(nil)
+		mockRepo.On("CreateAccountToken", mock.AnythingOfType("*domain.AccountToken")).Return(nil)
+		mockMailer.On("Send", mock.Anything).Return(nil)
+
+		service := NewServiceWithMailer(mockRepo, mockMailer, "https://atlas.example.com")
+		for i := 0; i < defaultAccountEmailPolicy.PerAccount; i++ {
+			assert.NoError(t, service.ResendVerificationEmail(2))
+		}
+
+		err := service.ResendVerificationEmail(2)
+
+		assert.ErrorIs(t, err, ErrAccountEmailThrottled)
+		mockMailer.AssertNumberOfCalls(t, "Send", defaultAccountEmailPolicy.PerAccount)
+	})
+}
+
+func TestService_RegisterSendsVerificationEmail(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockMailer := new(MockMailer)
+	mockRepo.On("EmailExists", "new@example.com").Return(false, nil)
+	mockRepo.On("Create", mock.AnythingOfType("*domain.User")).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.User).ID = 3
+	}).Return(nil)
+	mockRepo.On("InvalidateAccountTokens", uint(3), "email_verification").Return(nil)
+	mockRepo.On("CreateAccountToken", mock.MatchedBy(func(token *domain.AccountToken) bool {
+		return token.UserID == 3 && token.Purpose == "email_verification"
+	})).Return(nil)
+	mockRepo.On("CreateSession", mock.AnythingOfType("*domain.Session")).Return(nil)
+	mockMailer.On("Send", mock.MatchedBy(func(msg mail.Message) bool {
+		return msg.To == "new@example.com" && strings.Contains(msg.Body, "https://atlas.example.com/verify-email?token=")
+	})).Return(nil)
+
+	service := NewServiceWithMailer(mockRepo, mockMailer, "https://atlas.example.com")
+	user, _, err := service.Register(RegisterInput{Email: "new@example.com", Password: "password123", DisplayName: "New"}, SessionMeta{})
+
+	assert.NoError(t, err)
+	assert.False(t, ToUserResponse(user).EmailVerified)
+	mockRepo.AssertExpectations(t)
+	mockMailer.AssertExpectations(t)
+}
+
+func TestHandler_ForgotPassword(t *testing.T) {
+	post := func(router http.Handler, email string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(ForgotPasswordInput{Email: email})
+		req, _ := http.NewRequest("POST", "/api/v1/auth/forgot-password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.RemoteAddr = "203.0.113.7:51234"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("accepts unknown email", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", "nobody@example.com").Return(nil, ErrUserNotFound)
+
+		router := setupTestRouter()
+		NewHandler(inline(NewServiceWithMailer(mockRepo, new(MockMailer), "")), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+		w := post(router, "nobody@example.com")
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("accepts request when the email cannot be sent", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockMailer := new(MockMailer)
+		mockRepo.On("GetByEmail", "jane@example.com").Return(&domain.User{ID: 2, Email: "jane@example.com"}, nil)
+		mockRepo.On("InvalidateAccountTokens", uint(2), "password_reset").Return(nil)
+		mockRepo.On("CreateAccountToken", mock.AnythingOfType("*domain.AccountToken")).Return(nil)
+		mockMailer.On("Send", mock.Anything).Return(errors.New("smtp unavailable"))
+
+		router := setupTestRouter()
+		NewHandler(inline(NewServiceWithMailer(mockRepo, mockMailer, "")), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+		w := post(router, "jane@example.com")
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		mockMailer.AssertExpectations(t)
+	})
+
+	t.Run("accepts request when no mailer is configured", func(t *testing.T) {
+		router := setupTestRouter()
+		NewHandler(NewServiceWithRepo(new(MockRepository)), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+		w := post(router, "jane@example.com")
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("returns 429 once the client IP is over its limit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByEmail", mock.Anything).Return(nil, ErrUserNotFound)
+
+		router := setupTestRouter()
+		NewHandler(inline(NewServiceWithMailer(mockRepo, new(MockMailer), "")), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+		for i := 0; i < defaultAccountEmailPolicy.PerIP; i++ {
+			post(router, "nobody@example.com")
+		}
+
+		w := post(router, "nobody@example.com")
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.NotEmpty(t, w.Header().Get("Retry-After"))
+	})
+}
+
+func TestHandler_ResetPassword_InvalidToken(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockRepo.On("ResetPassword", hashToken("bad-token"), mock.Anything).Return(ErrAccountTokenNotFound)
+
+	router := setupTestRouter()
+	NewHandler(NewServiceWithRepo(mockRepo), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+	body, _ := json.Marshal(ResetPasswordInput{Token: "bad-token", Password: "new-password"})
+	req, _ := http.NewRequest("POST", "/api/v1/auth/reset-password", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "INVALID_TOKEN")
+}
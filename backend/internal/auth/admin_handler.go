@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
+)
+
+// RegisterAdminRoutes registers admin user management routes
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	users := rg.Group("/users")
+	{
+		users.GET("", h.AdminListUsers)
+		users.GET("/:id", h.AdminGetUser)
+		users.GET("/:id/logins", h.AdminListLoginAttempts)
+		users.PATCH("/:id/role", h.AdminUpdateRole)
+		users.PATCH("/:id/suspend", h.AdminSuspendUser)
+		users.PATCH("/:id/unsuspend", h.AdminUnsuspendUser)
+	}
+}
+
+// AdminListUsers handles GET /api/v1/admin/users
+func (h *Handler) AdminListUsers(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+
+	filters := UserFilters{
+		Query:  c.Query("q"),
+		Role:   c.Query("role"),
+		Status: c.Query("status"),
+	}
+
+	users, total, err := h.service.ListUsers(filters, page, pageSize)
+	if err != nil {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch users", nil)
+		return
+	}
+
+	responses.List(c, users, map[string]interface{}{
+		"page":      page,
+		"page_size": pageSize,
+		"total":     total,
+	})
+}
+
+// AdminGetUser handles GET /api/v1/admin/users/:id
+func (h *Handler) AdminGetUser(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	summary, err := h.service.GetUserSummary(id)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "User not found", nil)
+			return
+		}
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch user", nil)
+		return
+	}
+
+	responses.Success(c, summary)
+}
+
+// AdminListLoginAttempts handles GET /api/v1/admin/users/:id/logins
+func (h *Handler) AdminListLoginAttempts(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+
+	attempts, total, err := h.service.ListLoginAttempts(id, page, pageSize)
+	if err != nil {
+		writeUserAdminError(c, err, "Failed to fetch login history")
+		return
+	}
+
+	responses.List(c, attempts, map[string]interface{}{
+		"page":      page,
+		"page_size": pageSize,
+		"total":     total,
+	})
+}
+
+// AdminUpdateRole handles PATCH /api/v1/admin/users/:id/role
+func (h *Handler) AdminUpdateRole(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var input UpdateRoleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.service.UpdateUserRole(id, input.Role, actorID)
+	if err != nil {
+		writeUserAdminError(c, err, "Failed to update role")
+		return
+	}
+
+	responses.Success(c, user)
+}
+
+// AdminSuspendUser handles PATCH /api/v1/admin/users/:id/suspend
+func (h *Handler) AdminSuspendUser(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var input SuspendInput
+	// Input is optional, so we don't fail on binding error
+	_ = c.ShouldBindJSON(&input)
+
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.service.SuspendUser(id, input, actorID)
+	if err != nil {
+		writeUserAdminError(c, err, "Failed to suspend user")
+		return
+	}
+
+	responses.Success(c, user)
+}
+
+// AdminUnsuspendUser handles PATCH /api/v1/admin/users/:id/unsuspend
+func (h *Handler) AdminUnsuspendUser(c *gin.Context) {
+	id, ok := parseUserID(c)
+	if !ok {
+		return
+	}
+
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.service.UnsuspendUser(id, actorID)
+	if err != nil {
+		writeUserAdminError(c, err, "Failed to unsuspend user")
+		return
+	}
+
+	responses.Success(c, user)
+}
+
+// parseUserID reads the :id path parameter, writing a 400 response if it is invalid
+func parseUserID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// currentUserID reads the authenticated user's ID, writing an error response if it is missing
+func currentUserID(c *gin.Context) (uint, bool) {
+	userIDVal, exists := c.Get("user_id")
+	if !exists {
+		responses.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return 0, false
+	}
+	userID, ok := userIDVal.(uint)
+	if !ok {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Invalid user context", nil)
+		return 0, false
+	}
+	return userID, true
+}
+
+// writeUserAdminError maps user management errors to HTTP responses
+func writeUserAdminError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrUserNotFound):
+		responses.Error(c, http.StatusNotFound, "NOT_FOUND", "User not found", nil)
+	case errors.Is(err, ErrInvalidRole):
+		responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid role. Must be: user, moderator, or admin", nil)
+	case errors.Is(err, ErrCannotModifySelf):
+		responses.Error(c, http.StatusUnprocessableEntity, "CANNOT_MODIFY_SELF", "You cannot change your own role or suspension", nil)
+	case errors.Is(err, ErrAlreadySuspended):
+		responses.Error(c, http.StatusConflict, "ALREADY_SUSPENDED", "User is already suspended", nil)
+	case errors.Is(err, ErrNotSuspended):
+		responses.Error(c, http.StatusConflict, "NOT_SUSPENDED", "User is not suspended", nil)
+	default:
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", fallback, nil)
+	}
+}
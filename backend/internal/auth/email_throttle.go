@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrAccountEmailThrottled matches the AccountEmailThrottledError returned while account emails are rate limited
+var ErrAccountEmailThrottled = errors.New("too many account emails requested")
+
+// AccountEmailThrottledError is returned while a client IP or account has asked for too many
+// password reset or verification emails
+type AccountEmailThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *AccountEmailThrottledError) Error() string {
+	return fmt.Sprintf("%s, retry in %s", ErrAccountEmailThrottled, e.RetryAfter.Round(time.Second))
+}
+
+// Is lets errors.Is(err, ErrAccountEmailThrottled) match
+func (e *AccountEmailThrottledError) Is(target error) bool {
+	return target == ErrAccountEmailThrottled
+}
+
+// accountEmailPolicy limits how many password reset and verification emails can be requested
+type accountEmailPolicy struct {
+	Window     time.Duration
+	PerIP      int // Forgot password requests per client IP, whether or not the account exists
+	PerAccount int // Emails sent to one account, so nobody can flood a mailbox
+}
+
+var defaultAccountEmailPolicy = accountEmailPolicy{
+	Window:     time.Hour,
+	PerIP:      10,
+	PerAccount: 3,
+}
+
+// windowLimiter counts requests per key in fixed windows. Counts are kept in memory,
+// so limits apply per API instance.
+type windowLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	start  time.Time
+	counts map[string]int
+}
+
+func newWindowLimiter(limit int, window time.Duration) *windowLimiter {
+	return &windowLimiter{limit: limit, window: window, counts: make(map[string]int)}
+}
+
+// allow records a request for key. It returns how long to wait if key is over the limit, or 0.
+func (l *windowLimiter) allow(key string, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.start) >= l.window {
+		l.start = now
+		clear(l.counts)
+	}
+	if l.counts[key] >= l.limit {
+		return l.start.Add(l.window).Sub(now)
+	}
+	l.counts[key]++
+	return 0
+}
+
+// accountEmailLimiters tracks account email requests per client IP and per account
+type accountEmailLimiters struct {
+	byIP      *windowLimiter
+	byAccount *windowLimiter
+}
+
+func newAccountEmailLimiters(policy accountEmailPolicy) accountEmailLimiters {
+	return accountEmailLimiters{
+		byIP:      newWindowLimiter(policy.PerIP, policy.Window),
+		byAccount: newWindowLimiter(policy.PerAccount, policy.Window),
+	}
+}
+
+// allowIP records a request from a client IP, returning an AccountEmailThrottledError over the limit
+func (l accountEmailLimiters) allowIP(ip string) error {
+	if ip == "" {
+		return nil
+	}
+	if retryAfter := l.byIP.allow(ip, time.Now()); retryAfter > 0 {
+		return &AccountEmailThrottledError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// allowAccount records an email to a user, returning an AccountEmailThrottledError over the limit
+func (l accountEmailLimiters) allowAccount(userID uint) error {
+	if retryAfter := l.byAccount.allow(fmt.Sprint(userID), time.Now()); retryAfter > 0 {
+		return &AccountEmailThrottledError{RetryAfter: retryAfter}
+	}
+	return nil
+}
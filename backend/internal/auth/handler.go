@@ -2,13 +2,22 @@ package auth
 
 import (
 	"errors"
+	"math"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/your-org/ai-tools-atlas-backend/internal/bookmarks"
 )
 
+// Refresh token cookie; scoped to the auth routes so it is not sent with every request
+const (
+	refreshCookieName = "refresh_token"
+	refreshCookiePath = "/api/v1/auth"
+)
+
 // Handler handles authentication HTTP requests
 type Handler struct {
 	service         *Service
@@ -30,10 +39,23 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup, authMiddleware gin.Handler
 		auth.POST("/register", h.Register)
 		auth.POST("/login", h.Login)
 		auth.POST("/logout", h.Logout)
+		auth.POST("/refresh", h.Refresh)
+		auth.POST("/forgot-password", h.ForgotPassword)
+		auth.POST("/reset-password", h.ResetPassword)
+		auth.POST("/verify-email", h.VerifyEmail)
+		auth.POST("/resend-verification", authMiddleware, h.ResendVerification)
 	}
 
 	// Protected route for current user
 	rg.GET("/me", authMiddleware, h.GetCurrentUser)
+
+	// Protected routes for the current user's sessions (logged-in devices)
+	me := rg.Group("/me")
+	{
+		me.GET("/sessions", authMiddleware, h.ListSessions)
+		me.DELETE("/sessions", authMiddleware, h.RevokeOtherSessions)
+		me.DELETE("/sessions/:id", authMiddleware, h.RevokeSession)
+	}
 }
 
 // Register handles user registration
@@ -49,7 +71,7 @@ func (h *Handler) Register(c *gin.Context) {
 		return
 	}
 
-	user, token, err := h.service.Register(input)
+	user, tokens, err := h.service.Register(input, sessionMeta(c))
 	if err != nil {
 		switch {
 		case errors.Is(err, ErrInvalidEmail):
@@ -95,7 +117,7 @@ func (h *Handler) Register(c *gin.Context) {
 	h.migrateSessionBookmarks(c, user.ID)
 
 	// Set auth cookie
-	h.setAuthCookie(c, token)
+	h.setAuthCookie(c, tokens)
 
 	c.JSON(http.StatusCreated, gin.H{
 		"data": gin.H{
@@ -117,7 +139,7 @@ func (h *Handler) Login(c *gin.Context) {
 		return
 	}
 
-	user, token, err := h.service.Login(input)
+	user, tokens, err := h.service.Login(input, sessionMeta(c))
 	if err != nil {
 		if errors.Is(err, ErrInvalidCredentials) {
 			c.JSON(http.StatusUnauthorized, gin.H{
@@ -128,6 +150,26 @@ func (h *Handler) Login(c *gin.Context) {
 			})
 			return
 		}
+		if errors.Is(err, ErrUserSuspended) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": gin.H{
+					"code":    "ACCOUNT_SUSPENDED",
+					"message": "This account has been suspended",
+				},
+			})
+			return
+		}
+		var throttled *LoginThrottledError
+		if errors.As(err, &throttled) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"error": gin.H{
+					"code":    "TOO_MANY_ATTEMPTS",
+					"message": "Too many failed login attempts, please try again later",
+				},
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": gin.H{
 				"code":    "INTERNAL_ERROR",
@@ -141,7 +183,7 @@ func (h *Handler) Login(c *gin.Context) {
 	h.migrateSessionBookmarks(c, user.ID)
 
 	// Set auth cookie
-	h.setAuthCookie(c, token)
+	h.setAuthCookie(c, tokens)
 
 	c.JSON(http.StatusOK, gin.H{
 		"data": gin.H{
@@ -152,11 +194,74 @@ func (h *Handler) Login(c *gin.Context) {
 
 // Logout handles user logout
 func (h *Handler) Logout(c *gin.Context) {
-	// Clear the auth cookie
+	// Revoke the session so its tokens stop working, even if a copy was taken
+	refreshToken, _ := c.Cookie(refreshCookieName)
+	if err := h.service.Logout(refreshToken); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to logout",
+			},
+		})
+		return
+	}
+
+	// Clear the auth cookies
 	h.clearAuthCookie(c)
 	c.Status(http.StatusNoContent)
 }
 
+// Refresh exchanges the refresh token cookie for a new access token and refresh token
+func (h *Handler) Refresh(c *gin.Context) {
+	refreshToken, _ := c.Cookie(refreshCookieName)
+
+	user, tokens, err := h.service.Refresh(refreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrRefreshRaced):
+			// Keep the cookies: the request that won the race has just set fresh ones
+			c.JSON(http.StatusConflict, gin.H{
+				"error": gin.H{
+					"code":    "REFRESH_IN_PROGRESS",
+					"message": "Session was refreshed by another request, please retry",
+				},
+			})
+		case errors.Is(err, ErrInvalidRefreshToken):
+			h.clearAuthCookie(c)
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": gin.H{
+					"code":    "INVALID_REFRESH_TOKEN",
+					"message": "Session has expired, please log in again",
+				},
+			})
+		case errors.Is(err, ErrUserSuspended):
+			h.clearAuthCookie(c)
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": gin.H{
+					"code":    "ACCOUNT_SUSPENDED",
+					"message": "This account has been suspended",
+				},
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": gin.H{
+					"code":    "INTERNAL_ERROR",
+					"message": "Failed to refresh session",
+				},
+			})
+		}
+		return
+	}
+
+	h.setAuthCookie(c, tokens)
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": gin.H{
+			"user": ToUserResponse(user),
+		},
+	})
+}
+
 // GetCurrentUser returns the current authenticated user
 func (h *Handler) GetCurrentUser(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
@@ -207,24 +312,33 @@ func (h *Handler) GetCurrentUser(c *gin.Context) {
 	})
 }
 
-// setAuthCookie sets the JWT token as an HTTP-only cookie
-func (h *Handler) setAuthCookie(c *gin.Context, token string) {
+// setAuthCookie sets the access token and refresh token as HTTP-only cookies.
+// The refresh token is only sent to the auth endpoints that use it.
+func (h *Handler) setAuthCookie(c *gin.Context, tokens *AuthTokens) {
 	secure := os.Getenv("APP_ENV") == "production"
-	maxAge := 7 * 24 * 60 * 60 // 7 days in seconds
 
 	c.SetSameSite(http.SameSiteLaxMode)
 	c.SetCookie(
 		"auth_token",
-		token,
-		maxAge,
+		tokens.AccessToken,
+		secondsUntil(tokens.AccessExpiresAt),
 		"/",
 		"",     // domain
 		secure, // secure
 		true,   // httpOnly
 	)
+	c.SetCookie(
+		refreshCookieName,
+		tokens.RefreshToken,
+		secondsUntil(tokens.RefreshExpiresAt),
+		refreshCookiePath,
+		"",     // domain
+		secure, // secure
+		true,   // httpOnly
+	)
 }
 
-// clearAuthCookie removes the auth cookie
+// clearAuthCookie removes the access token and refresh token cookies
 func (h *Handler) clearAuthCookie(c *gin.Context) {
 	c.SetSameSite(http.SameSiteLaxMode)
 	c.SetCookie(
@@ -236,6 +350,7 @@ func (h *Handler) clearAuthCookie(c *gin.Context) {
 		false, // secure
 		true,  // httpOnly
 	)
+	c.SetCookie(refreshCookieName, "", -1, refreshCookiePath, "", false, true)
 }
 
 // migrateSessionBookmarks moves anonymous session bookmarks to the user
@@ -261,3 +376,16 @@ func (h *Handler) migrateSessionBookmarks(c *gin.Context, userID uint) {
 		true,
 	)
 }
+
+// sessionMeta describes the device making the request
+func sessionMeta(c *gin.Context) SessionMeta {
+	return SessionMeta{
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	}
+}
+
+// secondsUntil returns a cookie max age for an expiry time
+func secondsUntil(t time.Time) int {
+	return int(time.Until(t).Seconds())
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -49,6 +50,148 @@ func (m *MockRepository) Update(user *domain.User) error {
 	return args.Error(0)
 }
 
+func (m *MockRepository) UpdateRole(userID uint, role string) error {
+	args := m.Called(userID, role)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Suspend(userID, suspendedBy uint, reason string, at time.Time) error {
+	args := m.Called(userID, suspendedBy, reason, at)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Unsuspend(userID uint) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) UpdateLastLogin(userID uint, at time.Time) error {
+	args := m.Called(userID, at)
+	return args.Error(0)
+}
+
+func (m *MockRepository) List(filters UserFilters, page, pageSize int) ([]domain.User, int64, error) {
+	args := m.Called(filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]domain.User), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRepository) GetActivity(userID uint) (*UserActivity, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserActivity), args.Error(1)
+}
+
+func (m *MockRepository) CreateSession(session *domain.Session) error {
+	args := m.Called(session)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetSession(id uint) (*domain.Session, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Session), args.Error(1)
+}
+
+func (m *MockRepository) GetSessionByTokenHash(hash string) (*domain.Session, error) {
+	args := m.Called(hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Session), args.Error(1)
+}
+
+func (m *MockRepository) RotateSession(id uint, oldHash, newHash string, usedAt time.Time) error {
+	args := m.Called(id, oldHash, newHash, usedAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListActiveSessions(userID uint) ([]domain.Session, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Session), args.Error(1)
+}
+
+func (m *MockRepository) RevokeSession(userID, sessionID uint) error {
+	args := m.Called(userID, sessionID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RevokeUserSessions(userID, exceptSessionID uint) error {
+	args := m.Called(userID, exceptSessionID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ResetPassword(tokenHash, passwordHash string) error {
+	args := m.Called(tokenHash, passwordHash)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkEmailVerified(userID uint, at time.Time) error {
+	args := m.Called(userID, at)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreateAccountToken(token *domain.AccountToken) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ConsumeAccountToken(purpose, hash string) (*domain.AccountToken, error) {
+	args := m.Called(purpose, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AccountToken), args.Error(1)
+}
+
+func (m *MockRepository) InvalidateAccountTokens(userID uint, purpose string) error {
+	args := m.Called(userID, purpose)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RecordLoginAttempt(attempt *domain.LoginAttempt) error {
+	args := m.Called(attempt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CompleteLoginAttempt(attempt *domain.LoginAttempt) error {
+	args := m.Called(attempt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CountEmailLoginFailures(email string, since time.Time, beforeID uint) (*LoginFailures, error) {
+	args := m.Called(email, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*LoginFailures), args.Error(1)
+}
+
+func (m *MockRepository) CountIPLoginFailures(ip string, since time.Time, beforeID uint) (*LoginFailures, error) {
+	args := m.Called(ip, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*LoginFailures), args.Error(1)
+}
+
+func (m *MockRepository) ListLoginAttempts(userID uint, page, pageSize int) ([]domain.LoginAttempt, int64, error) {
+	args := m.Called(userID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]domain.LoginAttempt), args.Get(1).(int64), args.Error(2)
+}
+
 // MockBookmarkService is a mock implementation of bookmarks.Service
 type MockBookmarkService struct {
 	mock.Mock
@@ -79,6 +222,22 @@ func (m *MockBookmarkService) MigrateSessionBookmarks(userID uint, sessionID str
 	return args.Error(0)
 }
 
+// expectLoginAllowed sets up a login that is not throttled and whose attempt is recorded with the given outcome.
+// Test requests have no client IP, so the per-IP check may not run.
+func expectLoginAllowed(mockRepo *MockRepository, success bool) {
+	mockRepo.On("CountEmailLoginFailures", mock.Anything, mock.AnythingOfType("time.Time")).Return(&LoginFailures{}, nil)
+	mockRepo.On("CountIPLoginFailures", mock.Anything, mock.AnythingOfType("time.Time")).Return(&LoginFailures{}, nil).Maybe()
+	mockRepo.On("RecordLoginAttempt", mock.MatchedBy(isPendingAttempt)).Return(nil)
+	mockRepo.On("CompleteLoginAttempt", mock.MatchedBy(func(attempt *domain.LoginAttempt) bool {
+		return attempt.Success == success
+	})).Return(nil)
+}
+
+// isPendingAttempt matches a login attempt reserved before the password is checked
+func isPendingAttempt(attempt *domain.LoginAttempt) bool {
+	return !attempt.Success && attempt.FailureReason != nil && *attempt.FailureReason == "pending"
+}
+
 func setupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	return gin.New()
@@ -93,6 +252,9 @@ func TestHandler_Register_Success(t *testing.T) {
 		user := args.Get(0).(*domain.User)
 		user.ID = 1 // Simulate database setting ID
 	}).Return(nil)
+	mockRepo.On("CreateSession", mock.MatchedBy(func(session *domain.Session) bool {
+		return session.UserID == 1 && len(session.RefreshTokenHash) == 64
+	})).Return(nil)
 
 	service := NewServiceWithRepo(mockRepo)
 	handler := NewHandler(service, nil)
@@ -234,6 +396,11 @@ func TestHandler_Login_Success(t *testing.T) {
 	}
 
 	mockRepo.On("GetByEmail", "test@example.com").Return(user, nil)
+	mockRepo.On("UpdateLastLogin", uint(1), mock.AnythingOfType("time.Time")).Return(nil)
+	expectLoginAllowed(mockRepo, true)
+	mockRepo.On("CreateSession", mock.AnythingOfType("*domain.Session")).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.Session).ID = 7
+	}).Return(nil)
 
 	handler := NewHandler(service, nil)
 
@@ -274,12 +441,29 @@ func TestHandler_Login_Success(t *testing.T) {
 	assert.NotNil(t, authCookie)
 	assert.True(t, authCookie.HttpOnly)
 
+	// Access token belongs to the new session, and a refresh token is scoped to the auth routes
+	claims, err := service.ValidateToken(authCookie.Value)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), claims.SessionID)
+
+	var refreshCookie *http.Cookie
+	for _, c := range cookies {
+		if c.Name == "refresh_token" {
+			refreshCookie = c
+			break
+		}
+	}
+	assert.NotNil(t, refreshCookie)
+	assert.True(t, refreshCookie.HttpOnly)
+	assert.Equal(t, "/api/v1/auth", refreshCookie.Path)
+
 	mockRepo.AssertExpectations(t)
 }
 
 func TestHandler_Login_InvalidCredentials(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockRepo.On("GetByEmail", "test@example.com").Return(nil, ErrUserNotFound)
+	expectLoginAllowed(mockRepo, false)
 
 	service := NewServiceWithRepo(mockRepo)
 	handler := NewHandler(service, nil)
@@ -380,7 +564,7 @@ func TestHandler_GetCurrentUser_Success(t *testing.T) {
 
 	// Auth middleware that sets user ID
 	authMiddleware := func(c *gin.Context) {
-		c.Set("userID", uint(1))
+		c.Set("user_id", uint(1))
 		c.Next()
 	}
 
@@ -402,3 +586,35 @@ func TestHandler_GetCurrentUser_Success(t *testing.T) {
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestHandler_Login_Suspended(t *testing.T) {
+	mockRepo := new(MockRepository)
+	service := NewServiceWithRepo(mockRepo)
+	hashedPassword, _ := service.HashPassword("password123")
+	suspendedAt := time.Now()
+	user := &domain.User{
+		ID:           1,
+		Email:        "test@example.com",
+		PasswordHash: hashedPassword,
+		Role:         "user",
+		SuspendedAt:  &suspendedAt,
+	}
+	mockRepo.On("GetByEmail", "test@example.com").Return(user, nil)
+	expectLoginAllowed(mockRepo, false)
+
+	handler := NewHandler(service, nil)
+
+	router := setupTestRouter()
+	v1 := router.Group("/api/v1")
+	handler.RegisterRoutes(v1, nil)
+
+	jsonBody, _ := json.Marshal(LoginInput{Email: "test@example.com", Password: "password123"})
+	req, _ := http.NewRequest("POST", "/api/v1/auth/login", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "ACCOUNT_SUSPENDED")
+	mockRepo.AssertNotCalled(t, "UpdateLastLogin", mock.Anything, mock.Anything)
+}
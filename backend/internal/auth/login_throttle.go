@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrLoginThrottled matches the LoginThrottledError returned while logins are locked out
+var ErrLoginThrottled = errors.New("too many failed login attempts")
+
+// LoginThrottledError is returned by Login while an email address or client IP is locked out
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("%s, retry in %s", ErrLoginThrottled, e.RetryAfter.Round(time.Second))
+}
+
+// Is lets errors.Is(err, ErrLoginThrottled) match
+func (e *LoginThrottledError) Is(target error) bool {
+	return target == ErrLoginThrottled
+}
+
+// Login failure reasons, matching the login_attempts.failure_reason CHECK constraint
+const (
+	failureInvalidCredentials = "invalid_credentials"
+	failureSuspended          = "suspended"
+	failureThrottled          = "throttled"
+	failurePending            = "pending" // Reserved before the password is checked
+)
+
+// loginPolicy controls throttling of failed logins. After the free attempts, each further
+// failure locks logins out for BaseDelay, doubling per failure up to MaxDelay.
+type loginPolicy struct {
+	Window            time.Duration // Failures older than this are forgotten
+	EmailFreeAttempts int
+	IPFreeAttempts    int // Higher than per email, since offices and NATs share an IP
+	BaseDelay         time.Duration
+	MaxDelay          time.Duration
+}
+
+var defaultLoginPolicy = loginPolicy{
+	Window:            time.Hour,
+	EmailFreeAttempts: 5,
+	IPFreeAttempts:    20,
+	BaseDelay:         30 * time.Second,
+	MaxDelay:          15 * time.Minute,
+}
+
+// retryAfter returns how long logins stay locked out after the given failures, or 0 if they are not
+func (p loginPolicy) retryAfter(failures *LoginFailures, freeAttempts int, now time.Time) time.Duration {
+	if failures.LastAt == nil || failures.Count < int64(freeAttempts) {
+		return 0
+	}
+
+	delay := p.MaxDelay
+	if excess := failures.Count - int64(freeAttempts); excess < 16 {
+		delay = min(p.BaseDelay<<excess, p.MaxDelay)
+	}
+
+	if remaining := failures.LastAt.Add(delay).Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// loginRetryAfter returns how long the email and client IP must wait before trying again,
+// counting the failed and pending attempts reserved before the attempt beforeID
+func (s *Service) loginRetryAfter(email, ip string, beforeID uint) (time.Duration, error) {
+	now := time.Now()
+	since := now.Add(-s.loginPolicy.Window)
+
+	byEmail, err := s.repo.CountEmailLoginFailures(email, since, beforeID)
+	if err != nil {
+		return 0, err
+	}
+	retryAfter := s.loginPolicy.retryAfter(byEmail, s.loginPolicy.EmailFreeAttempts, now)
+
+	if ip != "" {
+		byIP, err := s.repo.CountIPLoginFailures(ip, since, beforeID)
+		if err != nil {
+			return 0, err
+		}
+		retryAfter = max(retryAfter, s.loginPolicy.retryAfter(byIP, s.loginPolicy.IPFreeAttempts, now))
+	}
+
+	return retryAfter, nil
+}
+
+// reserveLoginAttempt adds a pending login attempt to the audit trail before anything is checked.
+// Pending attempts count as failures, so concurrent guesses each see the attempts reserved
+// before them and no more than the free attempts reach the password check.
+func (s *Service) reserveLoginAttempt(email string, meta SessionMeta) (*domain.LoginAttempt, error) {
+	reason := failurePending
+	attempt := &domain.LoginAttempt{
+		Email:         email,
+		IPAddress:     truncate(meta.IPAddress, 45),
+		UserAgent:     truncate(meta.UserAgent, 512),
+		FailureReason: &reason,
+	}
+	if err := s.repo.RecordLoginAttempt(attempt); err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// completeLoginAttempt records the outcome of a reserved attempt. An empty reason records a success.
+func (s *Service) completeLoginAttempt(attempt *domain.LoginAttempt, userID *uint, reason string) {
+	attempt.UserID = userID
+	attempt.Success = reason == ""
+	attempt.FailureReason = nil
+	if reason != "" {
+		attempt.FailureReason = &reason
+	}
+
+	if err := s.repo.CompleteLoginAttempt(attempt); err != nil {
+		log.Printf("Failed to record login attempt for %s: %v", attempt.Email, err)
+	}
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+// dummyPasswordHash returns a bcrypt hash to compare against when the email is unknown,
+// so those logins take as long as a wrong password for a real account
+func dummyPasswordHash() string {
+	dummyHashOnce.Do(func() {
+		hash, _ := bcrypt.GenerateFromPassword([]byte("not-a-real-password"), bcrypt.DefaultCost)
+		dummyHash = string(hash)
+	})
+	return dummyHash
+}
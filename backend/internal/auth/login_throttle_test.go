@@ -0,0 +1,227 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestLoginPolicy_RetryAfter(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-10 * time.Second)
+	old := now.Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		failures LoginFailures
+		expected time.Duration
+	}{
+		{"no failures", LoginFailures{}, 0},
+		{"below free attempts", LoginFailures{Count: 4, LastAt: &recent}, 0},
+		{"first lockout uses base delay", LoginFailures{Count: 5, LastAt: &recent}, 20 * time.Second},
+		{"delay doubles per failure", LoginFailures{Count: 7, LastAt: &recent}, 110 * time.Second},
+		{"delay is capped", LoginFailures{Count: 60, LastAt: &recent}, 15*time.Minute - 10*time.Second},
+		{"lockout expires", LoginFailures{Count: 6, LastAt: &old}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := defaultLoginPolicy.retryAfter(&tt.failures, 5, now)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestService_LoginThrottling(t *testing.T) {
+	recent := time.Now()
+
+	t.Run("locks out email after repeated failures", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("CountEmailLoginFailures", "jane@example.com", mock.Anything).Return(&LoginFailures{Count: 5, LastAt: &recent}, nil)
+		mockRepo.On("CountIPLoginFailures", "203.0.113.9", mock.Anything).Return(&LoginFailures{}, nil)
+		mockRepo.On("RecordLoginAttempt", mock.MatchedBy(func(attempt *domain.LoginAttempt) bool {
+			return isPendingAttempt(attempt) && attempt.IPAddress == "203.0.113.9"
+		})).Return(nil)
+		mockRepo.On("CompleteLoginAttempt", mock.MatchedBy(func(attempt *domain.LoginAttempt) bool {
+			return !attempt.Success && *attempt.FailureReason == "throttled"
+		})).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, _, err := service.Login(LoginInput{Email: "Jane@example.com", Password: "password123"}, SessionMeta{IPAddress: "203.0.113.9"})
+
+		assert.ErrorIs(t, err, ErrLoginThrottled)
+		var throttled *LoginThrottledError
+		assert.ErrorAs(t, err, &throttled)
+		assert.InDelta(t, 30*time.Second, throttled.RetryAfter, float64(time.Second))
+		// The password is not checked while locked out
+		mockRepo.AssertNotCalled(t, "GetByEmail", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("locks out client IP after repeated failures", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("CountEmailLoginFailures", "jane@example.com", mock.Anything).Return(&LoginFailures{}, nil)
+		mockRepo.On("CountIPLoginFailures", "203.0.113.9", mock.Anything).Return(&LoginFailures{Count: 20, LastAt: &recent}, nil)
+		mockRepo.On("RecordLoginAttempt", mock.AnythingOfType("*domain.LoginAttempt")).Return(nil)
+		mockRepo.On("CompleteLoginAttempt", mock.AnythingOfType("*domain.LoginAttempt")).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, _, err := service.Login(LoginInput{Email: "jane@example.com", Password: "password123"}, SessionMeta{IPAddress: "203.0.113.9"})
+
+		assert.ErrorIs(t, err, ErrLoginThrottled)
+	})
+
+	t.Run("records failure for unknown email", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("CountEmailLoginFailures", "nobody@example.com", mock.Anything).Return(&LoginFailures{}, nil)
+		mockRepo.On("GetByEmail", "nobody@example.com").Return(nil, ErrUserNotFound)
+		mockRepo.On("RecordLoginAttempt", mock.MatchedBy(isPendingAttempt)).Return(nil)
+		mockRepo.On("CompleteLoginAttempt", mock.MatchedBy(func(attempt *domain.LoginAttempt) bool {
+			return attempt.UserID == nil && *attempt.FailureReason == "invalid_credentials"
+		})).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, _, err := service.Login(LoginInput{Email: "nobody@example.com", Password: "password123"}, SessionMeta{})
+
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestHandler_Login_Throttled(t *testing.T) {
+	recent := time.Now()
+	mockRepo := new(MockRepository)
+	mockRepo.On("CountEmailLoginFailures", "test@example.com", mock.Anything).Return(&LoginFailures{Count: 6, LastAt: &recent}, nil)
+	mockRepo.On("RecordLoginAttempt", mock.AnythingOfType("*domain.LoginAttempt")).Return(nil)
+	mockRepo.On("CompleteLoginAttempt", mock.AnythingOfType("*domain.LoginAttempt")).Return(nil)
+
+	router := setupTestRouter()
+	NewHandler(NewServiceWithRepo(mockRepo), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+	jsonBody, _ := json.Marshal(LoginInput{Email: "test@example.com", Password: "password123"})
+	req, _ := http.NewRequest("POST", "/api/v1/auth/login", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Contains(t, w.Body.String(), "TOO_MANY_ATTEMPTS")
+	retryAfter, err := strconv.Atoi(w.Header().Get("Retry-After"))
+	assert.NoError(t, err)
+	assert.InDelta(t, 60, retryAfter, 1)
+}
+
+func TestHandler_AdminListLoginAttempts(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2}, nil)
+	mockRepo.On("ListLoginAttempts", uint(2), 1, 20).Return([]domain.LoginAttempt{
+		{ID: 9, Email: "jane@example.com", Success: true},
+	}, int64(1), nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/admin/users/2/logins", nil)
+	w := httptest.NewRecorder()
+	setupAdminRouter(NewServiceWithRepo(mockRepo), 1).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "jane@example.com")
+	mockRepo.AssertExpectations(t)
+}
+
+// attemptLogRepository keeps login attempts in memory, counting them the way the database does
+type attemptLogRepository struct {
+	Repository
+	user *domain.User
+
+	mu       sync.Mutex
+	attempts []domain.LoginAttempt
+}
+
+func (r *attemptLogRepository) GetByEmail(email string) (*domain.User, error) {
+	return r.user, nil
+}
+
+func (r *attemptLogRepository) RecordLoginAttempt(attempt *domain.LoginAttempt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	attempt.ID = uint(len(r.attempts) + 1)
+	attempt.CreatedAt = time.Now()
+	r.attempts = append(r.attempts, *attempt)
+	return nil
+}
+
+func (r *attemptLogRepository) CompleteLoginAttempt(attempt *domain.LoginAttempt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts[attempt.ID-1] = *attempt
+	return nil
+}
+
+func (r *attemptLogRepository) CountEmailLoginFailures(email string, since time.Time, beforeID uint) (*LoginFailures, error) {
+	return r.countFailures(beforeID), nil
+}
+
+func (r *attemptLogRepository) CountIPLoginFailures(ip string, since time.Time, beforeID uint) (*LoginFailures, error) {
+	return r.countFailures(beforeID), nil
+}
+
+func (r *attemptLogRepository) countFailures(beforeID uint) *LoginFailures {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	failures := &LoginFailures{}
+	for _, attempt := range r.attempts {
+		if attempt.ID >= beforeID || attempt.FailureReason == nil {
+			continue
+		}
+		if reason := *attempt.FailureReason; reason == "invalid_credentials" || reason == "pending" {
+			failures.Count++
+			failures.LastAt = &attempt.CreatedAt
+		}
+	}
+	return failures
+}
+
+func TestService_LoginThrottlingUnderConcurrency(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct-password"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	repo := &attemptLogRepository{user: &domain.User{ID: 2, Email: "jane@example.com", PasswordHash: string(hash)}}
+	service := NewServiceWithRepo(repo)
+
+	const guesses = 30
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		checked   int
+		throttled int
+	)
+	for i := 0; i < guesses; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := service.Login(LoginInput{Email: "jane@example.com", Password: "wrong-password"}, SessionMeta{IPAddress: "203.0.113.9"})
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, ErrInvalidCredentials):
+				checked++
+			case errors.Is(err, ErrLoginThrottled):
+				throttled++
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Only the free attempts reach the password check, however many guesses arrive at once
+	assert.Equal(t, defaultLoginPolicy.EmailFreeAttempts, checked)
+	assert.Equal(t, guesses-defaultLoginPolicy.EmailFreeAttempts, throttled)
+}
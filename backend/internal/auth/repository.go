@@ -2,9 +2,12 @@ package auth
 
 import (
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
@@ -19,6 +22,57 @@ type Repository interface {
 	GetByEmail(email string) (*domain.User, error)
 	EmailExists(email string) (bool, error)
 	Update(user *domain.User) error
+	UpdateLastLogin(userID uint, at time.Time) error
+
+	// Admin user management
+	List(filters UserFilters, page, pageSize int) ([]domain.User, int64, error)
+	GetActivity(userID uint) (*UserActivity, error)
+	UpdateRole(userID uint, role string) error
+	Suspend(userID, suspendedBy uint, reason string, at time.Time) error
+	Unsuspend(userID uint) error
+
+	// Sessions
+	CreateSession(session *domain.Session) error
+	GetSession(id uint) (*domain.Session, error)
+	GetSessionByTokenHash(hash string) (*domain.Session, error)
+	RotateSession(id uint, oldHash, newHash string, usedAt time.Time) error
+	ListActiveSessions(userID uint) ([]domain.Session, error)
+	RevokeSession(userID, sessionID uint) error
+	RevokeUserSessions(userID, exceptSessionID uint) error
+
+	// Password reset and email verification
+	ResetPassword(tokenHash, passwordHash string) error
+	MarkEmailVerified(userID uint, at time.Time) error
+	CreateAccountToken(token *domain.AccountToken) error
+	ConsumeAccountToken(purpose, hash string) (*domain.AccountToken, error)
+	InvalidateAccountTokens(userID uint, purpose string) error
+
+	// Login audit trail and throttling
+	RecordLoginAttempt(attempt *domain.LoginAttempt) error
+	CompleteLoginAttempt(attempt *domain.LoginAttempt) error
+	CountEmailLoginFailures(email string, since time.Time, beforeID uint) (*LoginFailures, error)
+	CountIPLoginFailures(ip string, since time.Time, beforeID uint) (*LoginFailures, error)
+	ListLoginAttempts(userID uint, page, pageSize int) ([]domain.LoginAttempt, int64, error)
+}
+
+// LoginFailures summarises recent failed logins for an email address or client IP
+type LoginFailures struct {
+	Count  int64
+	LastAt *time.Time
+}
+
+// UserFilters defines filters for listing users
+type UserFilters struct {
+	Query  string // Matches email or display name, ignoring case
+	Role   string
+	Status string // "active" or "suspended"
+}
+
+// UserActivity holds a user's contribution counts shown to admins
+type UserActivity struct {
+	ReviewCount     int64 `json:"review_count"`
+	ReportsFiled    int64 `json:"reports_filed"`
+	ReportsReceived int64 `json:"reports_received"` // Reports against the user's reviews
 }
 
 // repositoryImpl implements Repository using GORM
@@ -76,3 +130,320 @@ func (r *repositoryImpl) EmailExists(email string) (bool, error) {
 func (r *repositoryImpl) Update(user *domain.User) error {
 	return r.db.Save(user).Error
 }
+
+// UpdateLastLogin records when a user last logged in
+func (r *repositoryImpl) UpdateLastLogin(userID uint, at time.Time) error {
+	return r.db.Model(&domain.User{}).Where("id = ?", userID).UpdateColumn("last_login_at", at).Error
+}
+
+// List returns users matching the filters, newest first
+func (r *repositoryImpl) List(filters UserFilters, page, pageSize int) ([]domain.User, int64, error) {
+	var users []domain.User
+	var total int64
+
+	query := r.db.Model(&domain.User{})
+	if filters.Query != "" {
+		pattern := "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(filters.Query)) + "%"
+		query = query.Where("(LOWER(email) LIKE ? OR LOWER(display_name) LIKE ?)", pattern, pattern)
+	}
+	if filters.Role != "" {
+		query = query.Where("role = ?", filters.Role)
+	}
+	switch filters.Status {
+	case "active":
+		query = query.Where("suspended_at IS NULL")
+	case "suspended":
+		query = query.Where("suspended_at IS NOT NULL")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&users).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// GetActivity counts a user's reviews, the reports they filed and the reports against their reviews
+func (r *repositoryImpl) GetActivity(userID uint) (*UserActivity, error) {
+	var activity UserActivity
+	err := r.db.Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM reviews WHERE user_id = @user) AS review_count,
+			(SELECT COUNT(*) FROM reports WHERE reporter_user_id = @user) AS reports_filed,
+			(SELECT COUNT(*) FROM reports
+				JOIN reviews ON reviews.id = reports.reportable_id
+				WHERE reports.reportable_type = 'review' AND reports.reason <> 'pre_moderation'
+				AND reviews.user_id = @user) AS reports_received
+	`, map[string]interface{}{"user": userID}).Scan(&activity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &activity, nil
+}
+
+// CreateSession stores a new session
+func (r *repositoryImpl) CreateSession(session *domain.Session) error {
+	return r.db.Create(session).Error
+}
+
+// GetSession retrieves a session by ID
+func (r *repositoryImpl) GetSession(id uint) (*domain.Session, error) {
+	var session domain.Session
+	result := r.db.First(&session, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, result.Error
+	}
+	return &session, nil
+}
+
+// GetSessionByTokenHash finds the session whose current or previous refresh token has the given hash
+func (r *repositoryImpl) GetSessionByTokenHash(hash string) (*domain.Session, error) {
+	var session domain.Session
+	result := r.db.Where("refresh_token_hash = ? OR previous_token_hash = ?", hash, hash).First(&session)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, result.Error
+	}
+	return &session, nil
+}
+
+// RotateSession replaces a session's refresh token hash. It only succeeds if the session still holds
+// oldHash, so two concurrent refreshes with the same token cannot both rotate it.
+func (r *repositoryImpl) RotateSession(id uint, oldHash, newHash string, usedAt time.Time) error {
+	result := r.db.Model(&domain.Session{}).
+		Where("id = ? AND refresh_token_hash = ? AND revoked_at IS NULL", id, oldHash).
+		Updates(map[string]interface{}{
+			"refresh_token_hash":  newHash,
+			"previous_token_hash": oldHash,
+			"last_used_at":        usedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// ListActiveSessions returns a user's unrevoked, unexpired sessions, most recently used first
+func (r *repositoryImpl) ListActiveSessions(userID uint) ([]domain.Session, error) {
+	var sessions []domain.Session
+	err := r.db.
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC, id DESC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// RevokeSession revokes one of a user's active sessions
+func (r *repositoryImpl) RevokeSession(userID, sessionID uint) error {
+	result := r.db.Model(&domain.Session{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		UpdateColumn("revoked_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// UpdateRole changes only a user's role, so it cannot undo a concurrent suspension or password change
+func (r *repositoryImpl) UpdateRole(userID uint, role string) error {
+	result := r.db.Model(&domain.User{}).Where("id = ?", userID).UpdateColumn("role", role)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// Suspend marks an active user as suspended and, in the same transaction, revokes all their
+// sessions. It returns ErrAlreadySuspended if the user was suspended in the meantime.
+func (r *repositoryImpl) Suspend(userID, suspendedBy uint, reason string, at time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.User{}).
+			Where("id = ? AND suspended_at IS NULL", userID).
+			UpdateColumns(map[string]interface{}{
+				"suspended_at":      at,
+				"suspended_by":      suspendedBy,
+				"suspension_reason": reason,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrAlreadySuspended
+		}
+		return (&repositoryImpl{db: tx}).RevokeUserSessions(userID, 0)
+	})
+}
+
+// Unsuspend clears a user's suspension. It returns ErrNotSuspended if the user is not suspended.
+func (r *repositoryImpl) Unsuspend(userID uint) error {
+	result := r.db.Model(&domain.User{}).
+		Where("id = ? AND suspended_at IS NOT NULL", userID).
+		UpdateColumns(map[string]interface{}{
+			"suspended_at":      nil,
+			"suspended_by":      nil,
+			"suspension_reason": "",
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotSuspended
+	}
+	return nil
+}
+
+// RevokeUserSessions revokes all of a user's active sessions except exceptSessionID (0 revokes all)
+func (r *repositoryImpl) RevokeUserSessions(userID, exceptSessionID uint) error {
+	return r.db.Model(&domain.Session{}).
+		Where("user_id = ? AND id <> ? AND revoked_at IS NULL", userID, exceptSessionID).
+		UpdateColumn("revoked_at", time.Now()).Error
+}
+
+// ResetPassword consumes a password reset token and, in the same transaction, replaces the user's
+// password hash, marks their email verified and revokes all their sessions.
+// It returns ErrAccountTokenNotFound if the token is unknown, expired or already used.
+func (r *repositoryImpl) ResetPassword(tokenHash, passwordHash string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		txRepo := &repositoryImpl{db: tx}
+		token, err := txRepo.ConsumeAccountToken(purposePasswordReset, tokenHash)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&domain.User{}).Where("id = ?", token.UserID).Update("password_hash", passwordHash).Error
+		if err != nil {
+			return err
+		}
+		// Receiving the reset link proves the user controls the address
+		if err := txRepo.MarkEmailVerified(token.UserID, time.Now()); err != nil {
+			return err
+		}
+		// The old password may have been compromised, so end all existing sessions
+		return txRepo.RevokeUserSessions(token.UserID, 0)
+	})
+}
+
+// MarkEmailVerified records when a user confirmed their email address
+func (r *repositoryImpl) MarkEmailVerified(userID uint, at time.Time) error {
+	return r.db.Model(&domain.User{}).
+		Where("id = ? AND email_verified_at IS NULL", userID).
+		UpdateColumn("email_verified_at", at).Error
+}
+
+// CreateAccountToken stores a new password reset or email verification token
+func (r *repositoryImpl) CreateAccountToken(token *domain.AccountToken) error {
+	return r.db.Create(token).Error
+}
+
+// ConsumeAccountToken marks an unused, unexpired token as used and returns it.
+// The update is a single statement, so a token can only be consumed once.
+func (r *repositoryImpl) ConsumeAccountToken(purpose, hash string) (*domain.AccountToken, error) {
+	var token domain.AccountToken
+	now := time.Now()
+	result := r.db.Model(&token).
+		Clauses(clause.Returning{}).
+		Where("purpose = ? AND token_hash = ? AND used_at IS NULL AND expires_at > ?", purpose, hash, now).
+		UpdateColumn("used_at", now)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrAccountTokenNotFound
+	}
+	return &token, nil
+}
+
+// InvalidateAccountTokens marks a user's unused tokens for a purpose as used
+func (r *repositoryImpl) InvalidateAccountTokens(userID uint, purpose string) error {
+	return r.db.Model(&domain.AccountToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		UpdateColumn("used_at", time.Now()).Error
+}
+
+// RecordLoginAttempt stores a login attempt in the audit trail
+func (r *repositoryImpl) RecordLoginAttempt(attempt *domain.LoginAttempt) error {
+	return r.db.Create(attempt).Error
+}
+
+// CompleteLoginAttempt records the outcome of an attempt that was stored as pending
+func (r *repositoryImpl) CompleteLoginAttempt(attempt *domain.LoginAttempt) error {
+	return r.db.Model(attempt).Select("user_id", "success", "failure_reason").Updates(attempt).Error
+}
+
+// CountEmailLoginFailures counts wrong-password and still pending attempts for an email made
+// before the attempt beforeID, since the later of since and its last successful login
+func (r *repositoryImpl) CountEmailLoginFailures(email string, since time.Time, beforeID uint) (*LoginFailures, error) {
+	var failures LoginFailures
+	err := r.db.Model(&domain.LoginAttempt{}).
+		Select("COUNT(*) AS count, MAX(created_at) AS last_at").
+		Where("email = ? AND failure_reason IN ('invalid_credentials', 'pending') AND created_at > ? AND id < ?", email, since, beforeID).
+		Where("created_at > COALESCE((SELECT MAX(created_at) FROM login_attempts WHERE email = ? AND success), ?)", email, since).
+		Scan(&failures).Error
+	if err != nil {
+		return nil, err
+	}
+	return &failures, nil
+}
+
+// CountIPLoginFailures counts wrong-password and still pending attempts from a client IP made
+// before the attempt beforeID, since the given time. Successful logins do not reset it, so an
+// attacker cannot clear it by logging into their own account.
+func (r *repositoryImpl) CountIPLoginFailures(ip string, since time.Time, beforeID uint) (*LoginFailures, error) {
+	var failures LoginFailures
+	err := r.db.Model(&domain.LoginAttempt{}).
+		Select("COUNT(*) AS count, MAX(created_at) AS last_at").
+		Where("ip_address = ? AND failure_reason IN ('invalid_credentials', 'pending') AND created_at > ? AND id < ?", ip, since, beforeID).
+		Scan(&failures).Error
+	if err != nil {
+		return nil, err
+	}
+	return &failures, nil
+}
+
+// ListLoginAttempts returns a user's login attempts, newest first
+func (r *repositoryImpl) ListLoginAttempts(userID uint, page, pageSize int) ([]domain.LoginAttempt, int64, error) {
+	var attempts []domain.LoginAttempt
+	var total int64
+
+	query := r.db.Model(&domain.LoginAttempt{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&attempts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return attempts, total, nil
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"errors"
+	"log"
 	"os"
 	"regexp"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/mail"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -21,9 +23,10 @@ var (
 
 // JWT Claims structure
 type Claims struct {
-	UserID uint   `json:"user_id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserID    uint   `json:"user_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	SessionID uint   `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -42,18 +45,25 @@ type LoginInput struct {
 
 // UserResponse is the safe user data returned to clients
 type UserResponse struct {
-	ID          uint      `json:"id"`
-	Email       string    `json:"email"`
-	DisplayName string    `json:"display_name"`
-	Role        string    `json:"role"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID            uint      `json:"id"`
+	Email         string    `json:"email"`
+	DisplayName   string    `json:"display_name"`
+	Role          string    `json:"role"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 // Service handles authentication operations
 type Service struct {
-	jwtSecret     []byte
-	tokenDuration time.Duration
-	repo          Repository
+	jwtSecret       []byte
+	tokenDuration   time.Duration // Access token lifetime
+	refreshDuration time.Duration // Session and refresh token lifetime
+	repo            Repository
+	mailer          mail.Mailer
+	appURL          string // Frontend base URL for links in emails
+	loginPolicy     loginPolicy
+	emailLimits     accountEmailLimiters
+	background      func(func()) // Runs work after the request returns; replaced in tests
 }
 
 // NewService creates a new auth service
@@ -64,8 +74,12 @@ func NewService() *Service {
 	}
 
 	return &Service{
-		jwtSecret:     []byte(secret),
-		tokenDuration: 7 * 24 * time.Hour, // 7 days
+		jwtSecret:       []byte(secret),
+		tokenDuration:   accessTokenDuration,
+		refreshDuration: refreshTokenDuration,
+		loginPolicy:     defaultLoginPolicy,
+		emailLimits:     newAccountEmailLimiters(defaultAccountEmailPolicy),
+		background:      runInBackground,
 	}
 }
 
@@ -77,32 +91,44 @@ func NewServiceWithRepo(repo Repository) *Service {
 	}
 
 	return &Service{
-		jwtSecret:     []byte(secret),
-		tokenDuration: 7 * 24 * time.Hour, // 7 days
-		repo:          repo,
+		jwtSecret:       []byte(secret),
+		tokenDuration:   accessTokenDuration,
+		refreshDuration: refreshTokenDuration,
+		repo:            repo,
+		loginPolicy:     defaultLoginPolicy,
+		emailLimits:     newAccountEmailLimiters(defaultAccountEmailPolicy),
+		background:      runInBackground,
 	}
 }
 
-// Register creates a new user account
-func (s *Service) Register(input RegisterInput) (*domain.User, string, error) {
+// NewServiceWithMailer creates a new auth service that emails password reset and verification links
+func NewServiceWithMailer(repo Repository, mailer mail.Mailer, appURL string) *Service {
+	s := NewServiceWithRepo(repo)
+	s.mailer = mailer
+	s.appURL = appURL
+	return s
+}
+
+// Register creates a new user account and starts a session for it
+func (s *Service) Register(input RegisterInput, meta SessionMeta) (*domain.User, *AuthTokens, error) {
 	// Validate input
 	if err := s.validateRegistration(input); err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	// Check if email already exists
 	exists, err := s.repo.EmailExists(input.Email)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 	if exists {
-		return nil, "", ErrEmailAlreadyExists
+		return nil, nil, ErrEmailAlreadyExists
 	}
 
 	// Hash password
 	hashedPassword, err := s.HashPassword(input.Password)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	// Create user
@@ -114,41 +140,81 @@ func (s *Service) Register(input RegisterInput) (*domain.User, string, error) {
 	}
 
 	if err := s.repo.Create(user); err != nil {
-		return nil, "", err
+		return nil, nil, err
+	}
+
+	// Ask the user to confirm their address; the account works without it
+	if s.mailer != nil {
+		if err := s.sendVerificationEmail(user); err != nil {
+			log.Printf("Failed to send verification email to user %d: %v", user.ID, err)
+		}
 	}
 
-	// Generate token
-	token, err := s.GenerateToken(user.ID, user.Email, user.Role)
+	// Start a session
+	tokens, err := s.startSession(user, meta)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	return user, token, nil
+	return user, tokens, nil
 }
 
-// Login authenticates a user and returns a token
-func (s *Service) Login(input LoginInput) (*domain.User, string, error) {
+// Login authenticates a user and starts a new session. Every attempt is recorded, and
+// repeated failures for an email or client IP lock logins out with a LoginThrottledError.
+func (s *Service) Login(input LoginInput, meta SessionMeta) (*domain.User, *AuthTokens, error) {
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+
+	// Reserve the attempt first, then don't check passwords while the email or client IP is locked out
+	attempt, err := s.reserveLoginAttempt(email, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+	retryAfter, err := s.loginRetryAfter(email, meta.IPAddress, attempt.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if retryAfter > 0 {
+		s.completeLoginAttempt(attempt, nil, failureThrottled)
+		return nil, nil, &LoginThrottledError{RetryAfter: retryAfter}
+	}
+
 	// Get user by email
-	user, err := s.repo.GetByEmail(strings.ToLower(strings.TrimSpace(input.Email)))
+	user, err := s.repo.GetByEmail(email)
 	if err != nil {
 		if errors.Is(err, ErrUserNotFound) {
-			return nil, "", ErrInvalidCredentials
+			// Spend as long as a wrong password would, so timing doesn't reveal which emails exist
+			_ = s.CheckPassword(input.Password, dummyPasswordHash())
+			s.completeLoginAttempt(attempt, nil, failureInvalidCredentials)
+			return nil, nil, ErrInvalidCredentials
 		}
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	// Check password
 	if err := s.CheckPassword(input.Password, user.PasswordHash); err != nil {
-		return nil, "", ErrInvalidCredentials
+		s.completeLoginAttempt(attempt, &user.ID, failureInvalidCredentials)
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	// Suspended users may not log in
+	if user.SuspendedAt != nil {
+		s.completeLoginAttempt(attempt, &user.ID, failureSuspended)
+		return nil, nil, ErrUserSuspended
 	}
 
-	// Generate token
-	token, err := s.GenerateToken(user.ID, user.Email, user.Role)
+	s.completeLoginAttempt(attempt, &user.ID, "")
+
+	now := time.Now()
+	user.LastLoginAt = &now
+	_ = s.repo.UpdateLastLogin(user.ID, now) // Log error but don't fail
+
+	// Start a session
+	tokens, err := s.startSession(user, meta)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	return user, token, nil
+	return user, tokens, nil
 }
 
 // GetCurrentUser returns the user by ID
@@ -159,11 +225,12 @@ func (s *Service) GetCurrentUser(userID uint) (*domain.User, error) {
 // ToUserResponse converts a User to a safe response
 func ToUserResponse(user *domain.User) UserResponse {
 	return UserResponse{
-		ID:          user.ID,
-		Email:       user.Email,
-		DisplayName: user.DisplayName,
-		Role:        user.Role,
-		CreatedAt:   user.CreatedAt,
+		ID:            user.ID,
+		Email:         user.Email,
+		DisplayName:   user.DisplayName,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerifiedAt != nil,
+		CreatedAt:     user.CreatedAt,
 	}
 }
 
@@ -188,13 +255,14 @@ func (s *Service) validateRegistration(input RegisterInput) error {
 	return nil
 }
 
-// GenerateToken creates a new JWT token for a user
-func (s *Service) GenerateToken(userID uint, email string, role string) (string, error) {
+// GenerateToken creates a new JWT access token for a user's session
+func (s *Service) GenerateToken(userID uint, email string, role string, sessionID uint) (string, error) {
 	now := time.Now()
 	claims := Claims{
-		UserID: userID,
-		Email:  email,
-		Role:   role,
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(s.tokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -22,8 +22,9 @@ func TestGenerateToken(t *testing.T) {
 		userID := uint(123)
 		email := "test@example.com"
 		role := "user"
+		sessionID := uint(42)
 
-		tokenString, err := service.GenerateToken(userID, email, role)
+		tokenString, err := service.GenerateToken(userID, email, role, sessionID)
 		if err != nil {
 			t.Fatalf("GenerateToken failed: %v", err)
 		}
@@ -58,26 +59,30 @@ func TestGenerateToken(t *testing.T) {
 			t.Errorf("Expected Email %s, got %s", email, claims.Email)
 		}
 
+		if claims.SessionID != sessionID {
+			t.Errorf("Expected SessionID %d, got %d", sessionID, claims.SessionID)
+		}
+
 		if claims.Role != role {
 			t.Errorf("Expected Role %s, got %s", role, claims.Role)
 		}
 	})
 
-	t.Run("token has correct expiration (7 days)", func(t *testing.T) {
-		tokenString, _ := service.GenerateToken(1, "test@example.com", "user")
+	t.Run("token has correct expiration (15 minutes)", func(t *testing.T) {
+		tokenString, _ := service.GenerateToken(1, "test@example.com", "user", 0)
 
 		token, _ := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 			return service.jwtSecret, nil
 		})
 
 		claims := token.Claims.(*Claims)
-		expectedExpiration := time.Now().Add(7 * 24 * time.Hour)
+		expectedExpiration := time.Now().Add(15 * time.Minute)
 		actualExpiration := claims.ExpiresAt.Time
 
 		// Allow 1 second tolerance
 		diff := actualExpiration.Sub(expectedExpiration)
 		if diff > time.Second || diff < -time.Second {
-			t.Errorf("Token expiration not set to 7 days. Expected ~%v, got %v", expectedExpiration, actualExpiration)
+			t.Errorf("Token expiration not set to 15 minutes. Expected ~%v, got %v", expectedExpiration, actualExpiration)
 		}
 	})
 }
@@ -90,7 +95,7 @@ func TestValidateToken(t *testing.T) {
 		userID := uint(456)
 		email := "valid@example.com"
 		role := "admin"
-		tokenString, _ := service.GenerateToken(userID, email, role)
+		tokenString, _ := service.GenerateToken(userID, email, role, 0)
 
 		// Validate it
 		claims, err := service.ValidateToken(tokenString)
@@ -126,7 +131,7 @@ func TestValidateToken(t *testing.T) {
 			jwtSecret:     []byte("wrong-secret"),
 			tokenDuration: 7 * 24 * time.Hour,
 		}
-		tokenString, _ := wrongService.GenerateToken(1, "test@example.com", "user")
+		tokenString, _ := wrongService.GenerateToken(1, "test@example.com", "user", 0)
 
 		// Try to validate with correct service
 		_, err := service.ValidateToken(tokenString)
@@ -142,7 +147,7 @@ func TestValidateToken(t *testing.T) {
 			tokenDuration: -1 * time.Hour, // Already expired
 		}
 
-		tokenString, _ := shortLivedService.GenerateToken(1, "test@example.com", "user")
+		tokenString, _ := shortLivedService.GenerateToken(1, "test@example.com", "user", 0)
 
 		_, err := service.ValidateToken(tokenString)
 		if err == nil {
@@ -245,7 +250,7 @@ func TestNewService(t *testing.T) {
 			t.Error("Service should have jwtSecret loaded from env")
 		}
 
-		expectedDuration := 7 * 24 * time.Hour
+		expectedDuration := 15 * time.Minute
 		if service.tokenDuration != expectedDuration {
 			t.Errorf("Expected token duration %v, got %v", expectedDuration, service.tokenDuration)
 		}
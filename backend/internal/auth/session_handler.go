@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
+)
+
+// ListSessions handles GET /api/v1/me/sessions
+func (h *Handler) ListSessions(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	sessions, err := h.service.ListSessions(userID, c.GetUint("auth_session_id"))
+	if err != nil {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch sessions", nil)
+		return
+	}
+
+	responses.Success(c, sessions)
+}
+
+// RevokeSession handles DELETE /api/v1/me/sessions/:id
+func (h *Handler) RevokeSession(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	sessionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_ID", "Invalid session ID", nil)
+		return
+	}
+
+	if err := h.service.RevokeSession(userID, uint(sessionID)); err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Session not found", nil)
+			return
+		}
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to revoke session", nil)
+		return
+	}
+
+	// Revoking the current session is a logout
+	if uint(sessionID) == c.GetUint("auth_session_id") {
+		h.clearAuthCookie(c)
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeOtherSessions handles DELETE /api/v1/me/sessions, logging out every device but this one
+func (h *Handler) RevokeOtherSessions(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RevokeOtherSessions(userID, c.GetUint("auth_session_id")); err != nil {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to revoke sessions", nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
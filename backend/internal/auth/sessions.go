@@ -0,0 +1,257 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+)
+
+var (
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrSessionRevoked      = errors.New("session has been revoked")
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid or expired")
+	ErrRefreshRaced        = errors.New("refresh token was just rotated by another request")
+)
+
+const (
+	accessTokenDuration  = 15 * time.Minute
+	refreshTokenDuration = 30 * 24 * time.Hour
+
+	// A rotated-out refresh token presented within this window is treated as a concurrent
+	// refresh (e.g. two tabs) rather than theft, and does not revoke the session
+	refreshReuseGrace = 10 * time.Second
+)
+
+// SessionMeta describes the device a session was started from
+type SessionMeta struct {
+	UserAgent string
+	IPAddress string
+}
+
+// AuthTokens are the credentials issued when a session starts or is refreshed
+type AuthTokens struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// SessionResponse is a session as shown to its owner
+type SessionResponse struct {
+	ID         uint      `json:"id"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+// Refresh exchanges a refresh token for a new access token and rotates the refresh token.
+// Presenting a token that was already rotated out revokes the whole session, since either
+// the client or an attacker is holding a stolen copy. Within refreshReuseGrace it returns
+// ErrRefreshRaced instead, as another request from the same client has just refreshed.
+func (s *Service) Refresh(refreshToken string) (*domain.User, *AuthTokens, error) {
+	if refreshToken == "" {
+		return nil, nil, ErrInvalidRefreshToken
+	}
+
+	hash := hashToken(refreshToken)
+	session, err := s.repo.GetSessionByTokenHash(hash)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return nil, nil, ErrInvalidRefreshToken
+		}
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	if session.RevokedAt != nil || now.After(session.ExpiresAt) {
+		return nil, nil, ErrInvalidRefreshToken
+	}
+	if session.RefreshTokenHash != hash {
+		if now.Sub(session.LastUsedAt) <= refreshReuseGrace {
+			return nil, nil, ErrRefreshRaced
+		}
+		if err := s.repo.RevokeSession(session.UserID, session.ID); err != nil && !errors.Is(err, ErrSessionNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, ErrInvalidRefreshToken
+	}
+
+	user, err := s.repo.GetByID(session.UserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, nil, ErrInvalidRefreshToken
+		}
+		return nil, nil, err
+	}
+	if user.SuspendedAt != nil {
+		if err := s.repo.RevokeSession(user.ID, session.ID); err != nil && !errors.Is(err, ErrSessionNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, ErrUserSuspended
+	}
+
+	newRefreshToken, err := generateSecureToken()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.repo.RotateSession(session.ID, hash, hashToken(newRefreshToken), now); err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			// Another request rotated or revoked the session first
+			return nil, nil, ErrRefreshRaced
+		}
+		return nil, nil, err
+	}
+
+	accessToken, err := s.GenerateToken(user.ID, user.Email, user.Role, session.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, &AuthTokens{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  now.Add(s.tokenDuration),
+		RefreshToken:     newRefreshToken,
+		RefreshExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+// Logout revokes the session a refresh token belongs to. Unknown or already revoked tokens are ignored.
+func (s *Service) Logout(refreshToken string) error {
+	if refreshToken == "" {
+		return nil
+	}
+
+	session, err := s.repo.GetSessionByTokenHash(hashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.repo.RevokeSession(session.UserID, session.ID); err != nil && !errors.Is(err, ErrSessionNotFound) {
+		return err
+	}
+	return nil
+}
+
+// ListSessions returns a user's active sessions, marking the one making the request
+func (s *Service) ListSessions(userID, currentSessionID uint) ([]SessionResponse, error) {
+	sessions, err := s.repo.ListActiveSessions(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		result[i] = SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == currentSessionID,
+		}
+	}
+	return result, nil
+}
+
+// RevokeSession revokes one of a user's sessions
+func (s *Service) RevokeSession(userID, sessionID uint) error {
+	return s.repo.RevokeSession(userID, sessionID)
+}
+
+// RevokeOtherSessions revokes all of a user's sessions except the current one
+func (s *Service) RevokeOtherSessions(userID, currentSessionID uint) error {
+	return s.repo.RevokeUserSessions(userID, currentSessionID)
+}
+
+// startSession stores a new session for the user and issues its first token pair
+func (s *Service) startSession(user *domain.User, meta SessionMeta) (*AuthTokens, error) {
+	refreshToken, err := generateSecureToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &domain.Session{
+		UserID:           user.ID,
+		RefreshTokenHash: hashToken(refreshToken),
+		UserAgent:        truncate(meta.UserAgent, 512),
+		IPAddress:        truncate(meta.IPAddress, 45),
+		ExpiresAt:        now.Add(s.refreshDuration),
+		LastUsedAt:       now,
+	}
+	if err := s.repo.CreateSession(session); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.GenerateToken(user.ID, user.Email, user.Role, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthTokens{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  now.Add(s.tokenDuration),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+// checkSession verifies that the session an access token was issued for is still active
+func (s *Service) checkSession(claims *Claims) error {
+	if claims.SessionID == 0 {
+		return ErrSessionRevoked
+	}
+
+	session, err := s.repo.GetSession(claims.SessionID)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return ErrSessionRevoked
+		}
+		return err
+	}
+	if session.UserID != claims.UserID || session.RevokedAt != nil {
+		return ErrSessionRevoked
+	}
+	return nil
+}
+
+// generateSecureToken returns a random, URL-safe token for refresh tokens and emailed links
+func generateSecureToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns the hex SHA-256 of a token, which is what the database stores
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// truncate shortens s to at most limit bytes, dropping invalid UTF-8 and never splitting a character
+func truncate(s string, limit int) string {
+	s = strings.ToValidUTF8(s, "")
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
@@ -0,0 +1,274 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+)
+
+func TestService_Refresh(t *testing.T) {
+	user := &domain.User{ID: 2, Email: "jane@example.com", Role: "user"}
+
+	t.Run("rotates refresh token", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		hash := hashToken("old-token")
+		mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{
+			ID: 5, UserID: 2, RefreshTokenHash: hash, ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+		mockRepo.On("GetByID", uint(2)).Return(user, nil)
+		mockRepo.On("RotateSession", uint(5), hash, mock.MatchedBy(func(newHash string) bool {
+			return newHash != hash && len(newHash) == 64
+		}), mock.AnythingOfType("time.Time")).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, tokens, err := service.Refresh("old-token")
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, "old-token", tokens.RefreshToken)
+		claims, err := service.ValidateToken(tokens.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), claims.SessionID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("reused token revokes session", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		hash := hashToken("rotated-token")
+		mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{
+			ID: 5, UserID: 2, RefreshTokenHash: hashToken("current-token"), PreviousTokenHash: hash,
+			ExpiresAt: time.Now().Add(time.Hour), LastUsedAt: time.Now().Add(-time.Minute),
+		}, nil)
+		mockRepo.On("RevokeSession", uint(2), uint(5)).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, _, err := service.Refresh("rotated-token")
+
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("concurrent refresh does not revoke session", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		hash := hashToken("rotated-token")
+		mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{
+			ID: 5, UserID: 2, RefreshTokenHash: hashToken("current-token"), PreviousTokenHash: hash,
+			ExpiresAt: time.Now().Add(time.Hour), LastUsedAt: time.Now(),
+		}, nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, _, err := service.Refresh("rotated-token")
+
+		assert.ErrorIs(t, err, ErrRefreshRaced)
+		mockRepo.AssertNotCalled(t, "RevokeSession", mock.Anything, mock.Anything)
+	})
+
+	t.Run("losing a rotation race is reported as a race", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		hash := hashToken("old-token")
+		mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{
+			ID: 5, UserID: 2, RefreshTokenHash: hash, ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+		mockRepo.On("GetByID", uint(2)).Return(user, nil)
+		mockRepo.On("RotateSession", uint(5), hash, mock.Anything, mock.Anything).Return(ErrSessionNotFound)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, _, err := service.Refresh("old-token")
+
+		assert.ErrorIs(t, err, ErrRefreshRaced)
+	})
+
+	t.Run("rejects revoked session", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		revokedAt := time.Now()
+		hash := hashToken("old-token")
+		mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{
+			ID: 5, UserID: 2, RefreshTokenHash: hash, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt,
+		}, nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, _, err := service.Refresh("old-token")
+
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	})
+
+	t.Run("rejects suspended user and revokes session", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		suspendedAt := time.Now()
+		hash := hashToken("old-token")
+		mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{
+			ID: 5, UserID: 2, RefreshTokenHash: hash, ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, SuspendedAt: &suspendedAt}, nil)
+		mockRepo.On("RevokeSession", uint(2), uint(5)).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, _, err := service.Refresh("old-token")
+
+		assert.ErrorIs(t, err, ErrUserSuspended)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestHandler_Refresh(t *testing.T) {
+	t.Run("missing cookie", func(t *testing.T) {
+		router := setupTestRouter()
+		NewHandler(NewServiceWithRepo(new(MockRepository)), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+		req, _ := http.NewRequest("POST", "/api/v1/auth/refresh", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_REFRESH_TOKEN")
+	})
+
+	t.Run("sets new cookies", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		hash := hashToken("old-token")
+		mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{
+			ID: 5, UserID: 2, RefreshTokenHash: hash, ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Email: "jane@example.com", Role: "user"}, nil)
+		mockRepo.On("RotateSession", uint(5), hash, mock.Anything, mock.Anything).Return(nil)
+
+		router := setupTestRouter()
+		NewHandler(NewServiceWithRepo(mockRepo), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+		req, _ := http.NewRequest("POST", "/api/v1/auth/refresh", nil)
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "old-token"})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		names := map[string]string{}
+		for _, c := range w.Result().Cookies() {
+			names[c.Name] = c.Value
+		}
+		assert.NotEmpty(t, names["auth_token"])
+		assert.NotEmpty(t, names["refresh_token"])
+		assert.NotEqual(t, "old-token", names["refresh_token"])
+	})
+
+	t.Run("keeps cookies when another request refreshed first", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		hash := hashToken("rotated-token")
+		mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{
+			ID: 5, UserID: 2, RefreshTokenHash: hashToken("current-token"), PreviousTokenHash: hash,
+			ExpiresAt: time.Now().Add(time.Hour), LastUsedAt: time.Now(),
+		}, nil)
+
+		router := setupTestRouter()
+		NewHandler(NewServiceWithRepo(mockRepo), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+		req, _ := http.NewRequest("POST", "/api/v1/auth/refresh", nil)
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "rotated-token"})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "REFRESH_IN_PROGRESS")
+		assert.Empty(t, w.Result().Cookies())
+	})
+}
+
+func TestHandler_LogoutRevokesSession(t *testing.T) {
+	mockRepo := new(MockRepository)
+	hash := hashToken("current-token")
+	mockRepo.On("GetSessionByTokenHash", hash).Return(&domain.Session{ID: 5, UserID: 2, RefreshTokenHash: hash}, nil)
+	mockRepo.On("RevokeSession", uint(2), uint(5)).Return(nil)
+
+	router := setupTestRouter()
+	NewHandler(NewServiceWithRepo(mockRepo), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/auth/logout", nil)
+	req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "current-token"})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHandler_Sessions(t *testing.T) {
+	setup := func(mockRepo *MockRepository) *gin.Engine {
+		router := setupTestRouter()
+		authMiddleware := func(c *gin.Context) {
+			c.Set("user_id", uint(2))
+			c.Set("auth_session_id", uint(5))
+			c.Next()
+		}
+		NewHandler(NewServiceWithRepo(mockRepo), nil).RegisterRoutes(router.Group("/api/v1"), authMiddleware)
+		return router
+	}
+
+	t.Run("lists sessions and marks current", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("ListActiveSessions", uint(2)).Return([]domain.Session{
+			{ID: 5, UserID: 2, UserAgent: "Firefox"},
+			{ID: 6, UserID: 2, UserAgent: "Safari"},
+		}, nil)
+
+		req, _ := http.NewRequest("GET", "/api/v1/me/sessions", nil)
+		w := httptest.NewRecorder()
+		setup(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			Data []SessionResponse `json:"data"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Len(t, response.Data, 2)
+		assert.True(t, response.Data[0].Current)
+		assert.False(t, response.Data[1].Current)
+	})
+
+	t.Run("revokes a session", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("RevokeSession", uint(2), uint(6)).Return(nil)
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/me/sessions/6", nil)
+		w := httptest.NewRecorder()
+		setup(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("revoking another user's session is not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("RevokeSession", uint(2), uint(9)).Return(ErrSessionNotFound)
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/me/sessions/9", nil)
+		w := httptest.NewRecorder()
+		setup(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("revokes all other sessions", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("RevokeUserSessions", uint(2), uint(5)).Return(nil)
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/me/sessions", nil)
+		w := httptest.NewRecorder()
+		setup(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "Firefox", truncate("Firefox", 512))
+	assert.Equal(t, "ab", truncate("abc", 2))
+	// "é" is two bytes, so cutting after 2 bytes would split it
+	assert.Equal(t, "a", truncate("aé", 2))
+	assert.Equal(t, "ab", truncate("a\xffb", 512))
+}
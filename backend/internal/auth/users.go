@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+)
+
+var (
+	ErrUserSuspended    = errors.New("account is suspended")
+	ErrInvalidRole      = errors.New("role must be user, moderator or admin")
+	ErrCannotModifySelf = errors.New("admins cannot change their own role or suspension")
+	ErrAlreadySuspended = errors.New("user is already suspended")
+	ErrNotSuspended     = errors.New("user is not suspended")
+)
+
+// Roles that can be assigned to users
+var validRoles = map[string]bool{
+	"user":      true,
+	"moderator": true,
+	"admin":     true,
+}
+
+// UpdateRoleInput represents input for changing a user's role
+type UpdateRoleInput struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// SuspendInput represents input for suspending a user
+type SuspendInput struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// AdminUserResponse is the user data shown to admins
+type AdminUserResponse struct {
+	UserResponse
+	SuspendedAt      *time.Time `json:"suspended_at"`
+	SuspensionReason string     `json:"suspension_reason,omitempty"`
+	LastLoginAt      *time.Time `json:"last_login_at"`
+}
+
+// UserSummary is a user with their activity, shown on the admin user page
+type UserSummary struct {
+	AdminUserResponse
+	UserActivity
+}
+
+// ToAdminUserResponse converts a User to the admin view
+func ToAdminUserResponse(user *domain.User) AdminUserResponse {
+	return AdminUserResponse{
+		UserResponse:     ToUserResponse(user),
+		SuspendedAt:      user.SuspendedAt,
+		SuspensionReason: user.SuspensionReason,
+		LastLoginAt:      user.LastLoginAt,
+	}
+}
+
+// ListUsers returns users matching the filters with pagination
+func (s *Service) ListUsers(filters UserFilters, page, pageSize int) ([]AdminUserResponse, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	filters.Query = strings.TrimSpace(filters.Query)
+
+	users, total, err := s.repo.List(filters, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]AdminUserResponse, len(users))
+	for i := range users {
+		result[i] = ToAdminUserResponse(&users[i])
+	}
+	return result, total, nil
+}
+
+// GetUserSummary returns a user with their review and report counts
+func (s *Service) GetUserSummary(userID uint) (*UserSummary, error) {
+	user, err := s.repo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := s.repo.GetActivity(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserSummary{
+		AdminUserResponse: ToAdminUserResponse(user),
+		UserActivity:      *activity,
+	}, nil
+}
+
+// ListLoginAttempts returns a user's login history, newest first
+func (s *Service) ListLoginAttempts(userID uint, page, pageSize int) ([]domain.LoginAttempt, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	if _, err := s.repo.GetByID(userID); err != nil {
+		return nil, 0, err
+	}
+	return s.repo.ListLoginAttempts(userID, page, pageSize)
+}
+
+// UpdateUserRole changes a user's role. Admins cannot change their own role.
+func (s *Service) UpdateUserRole(userID uint, role string, actorID uint) (*AdminUserResponse, error) {
+	if !validRoles[role] {
+		return nil, ErrInvalidRole
+	}
+	if userID == actorID {
+		return nil, ErrCannotModifySelf
+	}
+
+	user, err := s.repo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateRole(userID, role); err != nil {
+		return nil, err
+	}
+	user.Role = role
+
+	response := ToAdminUserResponse(user)
+	return &response, nil
+}
+
+// SuspendUser blocks a user from authenticating until they are unsuspended and logs them out
+// everywhere; they must sign in again once unsuspended
+func (s *Service) SuspendUser(userID uint, input SuspendInput, actorID uint) (*AdminUserResponse, error) {
+	if userID == actorID {
+		return nil, ErrCannotModifySelf
+	}
+
+	user, err := s.repo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.SuspendedAt != nil {
+		return nil, ErrAlreadySuspended
+	}
+
+	now := time.Now()
+	reason := strings.TrimSpace(input.Reason)
+	if err := s.repo.Suspend(userID, actorID, reason, now); err != nil {
+		return nil, err
+	}
+	user.SuspendedAt = &now
+	user.SuspendedBy = &actorID
+	user.SuspensionReason = reason
+
+	response := ToAdminUserResponse(user)
+	return &response, nil
+}
+
+// UnsuspendUser lets a suspended user authenticate again
+func (s *Service) UnsuspendUser(userID uint, actorID uint) (*AdminUserResponse, error) {
+	if userID == actorID {
+		return nil, ErrCannotModifySelf
+	}
+
+	user, err := s.repo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.SuspendedAt == nil {
+		return nil, ErrNotSuspended
+	}
+
+	if err := s.repo.Unsuspend(userID); err != nil {
+		return nil, err
+	}
+	user.SuspendedAt = nil
+	user.SuspendedBy = nil
+	user.SuspensionReason = ""
+
+	response := ToAdminUserResponse(user)
+	return &response, nil
+}
+
+// AuthenticateClaims checks that the user behind a valid token still exists and is not suspended
+// and that the token's session has not been revoked, and returns the user's current role.
+// Services without a repository trust the token's claims.
+func (s *Service) AuthenticateClaims(claims *Claims) (string, error) {
+	if s.repo == nil {
+		return claims.Role, nil
+	}
+
+	user, err := s.repo.GetByID(claims.UserID)
+	if err != nil {
+		return "", err
+	}
+	if user.SuspendedAt != nil {
+		return "", ErrUserSuspended
+	}
+	if err := s.checkSession(claims); err != nil {
+		return "", err
+	}
+	return user.Role, nil
+}
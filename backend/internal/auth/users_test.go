@@ -0,0 +1,267 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+)
+
+func setupAdminRouter(service *Service, actorID uint) *gin.Engine {
+	router := setupTestRouter()
+	admin := router.Group("/api/v1/admin")
+	admin.Use(func(c *gin.Context) {
+		c.Set("user_id", actorID)
+		c.Next()
+	})
+	NewHandler(service, nil).RegisterAdminRoutes(admin)
+	return router
+}
+
+func TestService_ListUsers(t *testing.T) {
+	mockRepo := new(MockRepository)
+	filters := UserFilters{Query: "jane", Status: "suspended"}
+	users := []domain.User{{ID: 2, Email: "jane@example.com", Role: "user"}}
+	mockRepo.On("List", filters, 1, 100).Return(users, int64(1), nil)
+
+	service := NewServiceWithRepo(mockRepo)
+	result, total, err := service.ListUsers(UserFilters{Query: "  jane ", Status: "suspended"}, 0, 500)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "jane@example.com", result[0].Email)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_GetUserSummary(t *testing.T) {
+	mockRepo := new(MockRepository)
+	lastLogin := time.Now()
+	mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Email: "jane@example.com", LastLoginAt: &lastLogin}, nil)
+	mockRepo.On("GetActivity", uint(2)).Return(&UserActivity{ReviewCount: 4, ReportsFiled: 1, ReportsReceived: 3}, nil)
+
+	service := NewServiceWithRepo(mockRepo)
+	summary, err := service.GetUserSummary(2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), summary.ReviewCount)
+	assert.Equal(t, int64(3), summary.ReportsReceived)
+	assert.Equal(t, &lastLogin, summary.LastLoginAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_UpdateUserRole(t *testing.T) {
+	t.Run("changes role", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Role: "user"}, nil)
+		mockRepo.On("UpdateRole", uint(2), "moderator").Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		user, err := service.UpdateUserRole(2, "moderator", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "moderator", user.Role)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown role", func(t *testing.T) {
+		service := NewServiceWithRepo(new(MockRepository))
+		_, err := service.UpdateUserRole(2, "superadmin", 1)
+		assert.ErrorIs(t, err, ErrInvalidRole)
+	})
+
+	t.Run("rejects changing own role", func(t *testing.T) {
+		service := NewServiceWithRepo(new(MockRepository))
+		_, err := service.UpdateUserRole(1, "user", 1)
+		assert.ErrorIs(t, err, ErrCannotModifySelf)
+	})
+}
+
+func TestService_SuspendUser(t *testing.T) {
+	t.Run("suspends active user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Role: "user"}, nil)
+		mockRepo.On("Suspend", uint(2), uint(1), "spam", mock.AnythingOfType("time.Time")).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		user, err := service.SuspendUser(2, SuspendInput{Reason: " spam "}, 1)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, user.SuspendedAt)
+		assert.Equal(t, "spam", user.SuspensionReason)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("reports a suspension made in the meantime", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Role: "user"}, nil)
+		mockRepo.On("Suspend", uint(2), uint(1), "", mock.AnythingOfType("time.Time")).Return(ErrAlreadySuspended)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, err := service.SuspendUser(2, SuspendInput{}, 1)
+
+		assert.ErrorIs(t, err, ErrAlreadySuspended)
+	})
+
+	t.Run("rejects already suspended user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		suspendedAt := time.Now()
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, SuspendedAt: &suspendedAt}, nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, err := service.SuspendUser(2, SuspendInput{}, 1)
+
+		assert.ErrorIs(t, err, ErrAlreadySuspended)
+	})
+
+	t.Run("unsuspends suspended user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		suspendedAt := time.Now()
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, SuspendedAt: &suspendedAt, SuspensionReason: "spam"}, nil)
+		mockRepo.On("Unsuspend", uint(2)).Return(nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		user, err := service.UnsuspendUser(2, 1)
+
+		assert.NoError(t, err)
+		assert.Nil(t, user.SuspendedAt)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unsuspend rejects active user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2}, nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, err := service.UnsuspendUser(2, 1)
+
+		assert.ErrorIs(t, err, ErrNotSuspended)
+	})
+}
+
+func TestService_AuthenticateClaims(t *testing.T) {
+	t.Run("returns current role", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Role: "moderator"}, nil)
+		mockRepo.On("GetSession", uint(5)).Return(&domain.Session{ID: 5, UserID: 2}, nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		role, err := service.AuthenticateClaims(&Claims{UserID: 2, Role: "user", SessionID: 5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "moderator", role)
+	})
+
+	t.Run("rejects suspended user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		suspendedAt := time.Now()
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, SuspendedAt: &suspendedAt}, nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, err := service.AuthenticateClaims(&Claims{UserID: 2, Role: "user"})
+
+		assert.ErrorIs(t, err, ErrUserSuspended)
+	})
+
+	t.Run("rejects revoked session", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		revokedAt := time.Now()
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Role: "user"}, nil)
+		mockRepo.On("GetSession", uint(5)).Return(&domain.Session{ID: 5, UserID: 2, RevokedAt: &revokedAt}, nil)
+
+		service := NewServiceWithRepo(mockRepo)
+		_, err := service.AuthenticateClaims(&Claims{UserID: 2, Role: "user", SessionID: 5})
+
+		assert.ErrorIs(t, err, ErrSessionRevoked)
+	})
+
+	t.Run("trusts claims without repository", func(t *testing.T) {
+		role, err := NewService().AuthenticateClaims(&Claims{UserID: 2, Role: "admin"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "admin", role)
+	})
+}
+
+func TestHandler_AdminGetUser(t *testing.T) {
+	t.Run("returns summary", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, Email: "jane@example.com"}, nil)
+		mockRepo.On("GetActivity", uint(2)).Return(&UserActivity{ReviewCount: 4}, nil)
+
+		router := setupAdminRouter(NewServiceWithRepo(mockRepo), 1)
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/users/2", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		data := response["data"].(map[string]interface{})
+		assert.Equal(t, "jane@example.com", data["email"])
+		assert.Equal(t, float64(4), data["review_count"])
+	})
+
+	t.Run("returns 404 for unknown user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetByID", uint(9)).Return(nil, ErrUserNotFound)
+
+		router := setupAdminRouter(NewServiceWithRepo(mockRepo), 1)
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/users/9", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestHandler_AdminUpdateRole(t *testing.T) {
+	t.Run("returns 422 for invalid role", func(t *testing.T) {
+		router := setupAdminRouter(NewServiceWithRepo(new(MockRepository)), 1)
+
+		req, _ := http.NewRequest("PATCH", "/api/v1/admin/users/2/role", bytes.NewBufferString(`{"role":"owner"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("returns 422 when changing own role", func(t *testing.T) {
+		router := setupAdminRouter(NewServiceWithRepo(new(MockRepository)), 1)
+
+		req, _ := http.NewRequest("PATCH", "/api/v1/admin/users/1/role", bytes.NewBufferString(`{"role":"user"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "CANNOT_MODIFY_SELF")
+	})
+}
+
+func TestHandler_AdminSuspendUser(t *testing.T) {
+	mockRepo := new(MockRepository)
+	suspendedAt := time.Now()
+	mockRepo.On("GetByID", uint(2)).Return(&domain.User{ID: 2, SuspendedAt: &suspendedAt}, nil)
+
+	router := setupAdminRouter(NewServiceWithRepo(mockRepo), 1)
+
+	req, _ := http.NewRequest("PATCH", "/api/v1/admin/users/2/suspend", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "ALREADY_SUSPENDED")
+}
@@ -6,7 +6,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/requests"
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
 )
 
@@ -60,7 +60,7 @@ func (h *Handler) AddBookmark(c *gin.Context) {
 
 	// Ensure we have either user or session
 	if userID == 0 && sessionID == "" {
-		sessionID = h.createSessionID(c)
+		sessionID = requests.NewSessionID(c)
 	}
 
 	var req AddBookmarkRequest
@@ -116,27 +116,6 @@ func (h *Handler) getUserOrSession(c *gin.Context) (uint, string) {
 	}
 
 	// Fall back to session ID
-	sessionID, _ := c.Cookie("session_id")
+	sessionID, _ := c.Cookie(requests.SessionCookie)
 	return 0, sessionID
 }
-
-// createSessionID generates a new session ID and sets it as a cookie
-func (h *Handler) createSessionID(c *gin.Context) string {
-	sessionID := uuid.New().String()
-	maxAge := 365 * 24 * 60 * 60 // 1 year
-
-	// Use secure cookies when not in development mode
-	secure := c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
-
-	c.SetCookie(
-		"session_id",
-		sessionID,
-		maxAge,
-		"/",
-		"",
-		secure,
-		true, // httpOnly - prevent XSS access
-	)
-
-	return sessionID
-}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
 )
 
@@ -59,20 +60,25 @@ func (h *Handler) ListToolsByCategory(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
 
-	tools, total, err := h.service.ListToolsByCategory(slug, page, pageSize)
+	tools, total, nextCursor, err := h.service.ListToolsByCategory(slug, page, pageSize, c.Query("cursor"))
 	if err != nil {
 		if errors.Is(err, ErrCategoryNotFound) {
 			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Category not found", nil)
 			return
 		}
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			responses.Error(c, http.StatusBadRequest, "INVALID_CURSOR", "Invalid or expired cursor", nil)
+			return
+		}
 		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch tools", nil)
 		return
 	}
 
 	responses.List(c, tools, map[string]interface{}{
-		"page":      page,
-		"page_size": pageSize,
-		"total":     total,
+		"page":        page,
+		"page_size":   pageSize,
+		"total":       total,
+		"next_cursor": pagination.NextCursor(nextCursor),
 	})
 }
 
@@ -34,12 +34,12 @@ func (m *MockService) GetCategoryBySlug(slug string) (*domain.Category, error) {
 	return args.Get(0).(*domain.Category), args.Error(1)
 }
 
-func (m *MockService) ListToolsByCategory(slug string, page, pageSize int) ([]domain.Tool, int64, error) {
-	args := m.Called(slug, page, pageSize)
+func (m *MockService) ListToolsByCategory(slug string, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error) {
+	args := m.Called(slug, page, pageSize, cursor)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, 0, "", args.Error(3)
 	}
-	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.String(2), args.Error(3)
 }
 
 func (m *MockService) ListCategoriesWithCount() ([]categories.CategoryWithCount, error) {
@@ -136,7 +136,7 @@ func TestListToolsByCategory(t *testing.T) {
 			{ID: 1, Slug: "chatgpt", Name: "ChatGPT"},
 			{ID: 2, Slug: "claude", Name: "Claude"},
 		}
-		mockService.On("ListToolsByCategory", "ai-writing", 1, 20).Return(expectedTools, int64(2), nil)
+		mockService.On("ListToolsByCategory", "ai-writing", 1, 20, "").Return(expectedTools, int64(2), "", nil)
 
 		router := setupTestRouter(mockService)
 		w := httptest.NewRecorder()
@@ -161,7 +161,7 @@ func TestListToolsByCategory(t *testing.T) {
 
 	t.Run("returns 404 for non-existent category", func(t *testing.T) {
 		mockService := new(MockService)
-		mockService.On("ListToolsByCategory", "non-existent", 1, 20).Return(nil, int64(0), categories.ErrCategoryNotFound)
+		mockService.On("ListToolsByCategory", "non-existent", 1, 20, "").Return(nil, int64(0), "", categories.ErrCategoryNotFound)
 
 		router := setupTestRouter(mockService)
 		w := httptest.NewRecorder()
@@ -174,7 +174,7 @@ func TestListToolsByCategory(t *testing.T) {
 
 	t.Run("uses custom pagination parameters", func(t *testing.T) {
 		mockService := new(MockService)
-		mockService.On("ListToolsByCategory", "ai-writing", 2, 10).Return([]domain.Tool{}, int64(0), nil)
+		mockService.On("ListToolsByCategory", "ai-writing", 2, 10, "").Return([]domain.Tool{}, int64(0), "", nil)
 
 		router := setupTestRouter(mockService)
 		w := httptest.NewRecorder()
@@ -2,6 +2,7 @@ package categories
 
 import (
 	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
 	"gorm.io/gorm"
 )
 
@@ -9,7 +10,7 @@ import (
 type Repository interface {
 	ListCategories() ([]Category, error)
 	GetCategoryBySlug(slug string) (*Category, error)
-	ListToolsByCategory(categoryID uint, page, pageSize int) ([]domain.Tool, int64, error)
+	ListToolsByCategory(categoryID uint, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error)
 	// Admin methods
 	GetCategoryByID(id uint) (*Category, error)
 	Create(category *Category) error
@@ -49,8 +50,17 @@ func (r *repository) GetCategoryBySlug(slug string) (*Category, error) {
 	return &category, nil
 }
 
-// ListToolsByCategory returns paginated tools for a category
-func (r *repository) ListToolsByCategory(categoryID uint, page, pageSize int) ([]domain.Tool, int64, error) {
+// categoryToolKeys orders category listings by rating, matching the tools top_rated sort
+var categoryToolKeys = []pagination.Key{
+	{Expr: "tools.bayesian_rating", Desc: true, Kind: pagination.KindFloat},
+	{Expr: "tools.review_count", Desc: true, Kind: pagination.KindInt},
+}
+
+// categoryToolSort labels cursors issued for category listings
+const categoryToolSort = "top_rated"
+
+// ListToolsByCategory returns paginated tools for a category and the cursor for the next page
+func (r *repository) ListToolsByCategory(categoryID uint, page, pageSize int, rawCursor string) ([]domain.Tool, int64, string, error) {
 	var toolsList []domain.Tool
 	var total int64
 
@@ -59,23 +69,49 @@ func (r *repository) ListToolsByCategory(categoryID uint, page, pageSize int) ([
 		Where("primary_category_id = ? AND archived_at IS NULL", categoryID).
 		Count(&total).Error
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
-	// Get paginated tools with preloaded relationships
-	offset := (page - 1) * pageSize
-	err = r.db.
+	query := r.db.
 		Preload("Tags").
 		Preload("PrimaryCategory").
-		Where("primary_category_id = ? AND archived_at IS NULL", categoryID).
-		Limit(pageSize).
-		Offset(offset).
+		Where("tools.primary_category_id = ? AND tools.archived_at IS NULL", categoryID)
+
+	// Continue after the cursor when given, otherwise use the page offset
+	cursor, err := pagination.Decode(rawCursor, categoryToolSort)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if cursor != nil {
+		condition, err := pagination.Condition(categoryToolKeys, "tools.id", cursor)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		query = query.Where(condition)
+	} else {
+		query = query.Offset((page - 1) * pageSize)
+	}
+
+	// Fetch one extra row to find out whether another page exists
+	err = query.
+		Order(pagination.OrderBy(categoryToolKeys, "tools.id")).
+		Limit(pageSize + 1).
 		Find(&toolsList).Error
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	if len(toolsList) <= pageSize {
+		return toolsList, total, "", nil
+	}
+
+	toolsList = toolsList[:pageSize]
+	last := toolsList[len(toolsList)-1]
+	values, err := pagination.Values(r.db, "tools", categoryToolKeys, "tools.id", last.ID)
+	if err != nil {
+		return nil, 0, "", err
 	}
 
-	return toolsList, total, nil
+	return toolsList, total, pagination.Encode(categoryToolSort, values, last.ID), nil
 }
 
 // GetCategoryByID finds a category by ID
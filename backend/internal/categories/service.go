@@ -42,7 +42,7 @@ type CategoryWithCount struct {
 type Service interface {
 	ListCategories() ([]Category, error)
 	GetCategoryBySlug(slug string) (*Category, error)
-	ListToolsByCategory(slug string, page, pageSize int) ([]domain.Tool, int64, error)
+	ListToolsByCategory(slug string, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error)
 	// Admin methods
 	ListCategoriesWithCount() ([]CategoryWithCount, error)
 	GetCategoryByID(id uint) (*Category, error)
@@ -78,12 +78,12 @@ func (s *service) GetCategoryBySlug(slug string) (*Category, error) {
 	return category, nil
 }
 
-// ListToolsByCategory returns paginated tools for a category slug
-func (s *service) ListToolsByCategory(slug string, page, pageSize int) ([]domain.Tool, int64, error) {
+// ListToolsByCategory returns paginated tools for a category slug and the cursor for the next page
+func (s *service) ListToolsByCategory(slug string, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error) {
 	// First get the category to find its ID
 	category, err := s.GetCategoryBySlug(slug)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	// Apply default pagination
@@ -94,7 +94,7 @@ func (s *service) ListToolsByCategory(slug string, page, pageSize int) ([]domain
 		pageSize = 20
 	}
 
-	return s.repo.ListToolsByCategory(category.ID, page, pageSize)
+	return s.repo.ListToolsByCategory(category.ID, page, pageSize, cursor)
 }
 
 // ListCategoriesWithCount returns all categories with their tool counts
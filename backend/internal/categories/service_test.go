@@ -31,12 +31,12 @@ func (m *MockRepository) GetCategoryBySlug(slug string) (*domain.Category, error
 	return args.Get(0).(*domain.Category), args.Error(1)
 }
 
-func (m *MockRepository) ListToolsByCategory(categoryID uint, page, pageSize int) ([]domain.Tool, int64, error) {
-	args := m.Called(categoryID, page, pageSize)
+func (m *MockRepository) ListToolsByCategory(categoryID uint, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error) {
+	args := m.Called(categoryID, page, pageSize, cursor)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, 0, "", args.Error(3)
 	}
-	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.String(2), args.Error(3)
 }
 
 func (m *MockRepository) GetCategoryByID(id uint) (*domain.Category, error) {
@@ -123,10 +123,10 @@ func TestServiceListToolsByCategory(t *testing.T) {
 		expectedTools := []domain.Tool{{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}}
 
 		mockRepo.On("GetCategoryBySlug", "ai-writing").Return(category, nil)
-		mockRepo.On("ListToolsByCategory", uint(1), 1, 20).Return(expectedTools, int64(1), nil)
+		mockRepo.On("ListToolsByCategory", uint(1), 1, 20, "").Return(expectedTools, int64(1), "", nil)
 
 		service := categories.NewService(mockRepo)
-		result, total, err := service.ListToolsByCategory("ai-writing", 1, 20)
+		result, total, _, err := service.ListToolsByCategory("ai-writing", 1, 20, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedTools, result)
@@ -139,10 +139,10 @@ func TestServiceListToolsByCategory(t *testing.T) {
 		category := &domain.Category{ID: 1, Slug: "ai-writing", Name: "AI Writing"}
 
 		mockRepo.On("GetCategoryBySlug", "ai-writing").Return(category, nil)
-		mockRepo.On("ListToolsByCategory", uint(1), 1, 20).Return([]domain.Tool{}, int64(0), nil)
+		mockRepo.On("ListToolsByCategory", uint(1), 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
 
 		service := categories.NewService(mockRepo)
-		_, _, err := service.ListToolsByCategory("ai-writing", 0, 0)
+		_, _, _, err := service.ListToolsByCategory("ai-writing", 0, 0, "")
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -153,10 +153,10 @@ func TestServiceListToolsByCategory(t *testing.T) {
 		category := &domain.Category{ID: 1, Slug: "ai-writing", Name: "AI Writing"}
 
 		mockRepo.On("GetCategoryBySlug", "ai-writing").Return(category, nil)
-		mockRepo.On("ListToolsByCategory", uint(1), 1, 20).Return([]domain.Tool{}, int64(0), nil)
+		mockRepo.On("ListToolsByCategory", uint(1), 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
 
 		service := categories.NewService(mockRepo)
-		_, _, err := service.ListToolsByCategory("ai-writing", 1, 200)
+		_, _, _, err := service.ListToolsByCategory("ai-writing", 1, 200, "")
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -167,7 +167,7 @@ func TestServiceListToolsByCategory(t *testing.T) {
 		mockRepo.On("GetCategoryBySlug", "non-existent").Return(nil, gorm.ErrRecordNotFound)
 
 		service := categories.NewService(mockRepo)
-		result, total, err := service.ListToolsByCategory("non-existent", 1, 20)
+		result, total, _, err := service.ListToolsByCategory("non-existent", 1, 20, "")
 
 		assert.Nil(t, result)
 		assert.Equal(t, int64(0), total)
@@ -61,6 +61,17 @@ type Tool struct {
 	PrimaryCategoryID uint       `json:"primary_category_id"`
 	PrimaryCategory   Category   `gorm:"foreignKey:PrimaryCategoryID" json:"primary_category,omitempty"`
 	AvgRatingOverall  float64    `gorm:"column:avg_rating_overall;default:0" json:"avg_rating_overall"`
+	BayesianRating    float64    `gorm:"column:bayesian_rating;default:0" json:"bayesian_rating"`
+	AvgEaseOfUse      *float64   `gorm:"column:avg_rating_ease_of_use" json:"avg_rating_ease_of_use"`
+	AvgValue          *float64   `gorm:"column:avg_rating_value" json:"avg_rating_value"`
+	AvgAccuracy       *float64   `gorm:"column:avg_rating_accuracy" json:"avg_rating_accuracy"`
+	AvgSpeed          *float64   `gorm:"column:avg_rating_speed" json:"avg_rating_speed"`
+	AvgSupport        *float64   `gorm:"column:avg_rating_support" json:"avg_rating_support"`
+	Rating1Count      int        `gorm:"column:rating_1_count;default:0" json:"rating_1_count"`
+	Rating2Count      int        `gorm:"column:rating_2_count;default:0" json:"rating_2_count"`
+	Rating3Count      int        `gorm:"column:rating_3_count;default:0" json:"rating_3_count"`
+	Rating4Count      int        `gorm:"column:rating_4_count;default:0" json:"rating_4_count"`
+	Rating5Count      int        `gorm:"column:rating_5_count;default:0" json:"rating_5_count"`
 	ReviewCount       int        `gorm:"default:0" json:"review_count"`
 	BookmarkCount     int        `gorm:"default:0" json:"bookmark_count"`
 	TrendingScore     float64    `gorm:"default:0" json:"trending_score"`
@@ -70,6 +81,7 @@ type Tool struct {
 	CreatedAt         time.Time  `json:"created_at"`
 	UpdatedAt         time.Time  `json:"updated_at"`
 	ArchivedAt        *time.Time `gorm:"index" json:"archived_at,omitempty"`
+	FlaggedAt         *time.Time `json:"-"` // Only shown to moderators
 }
 
 // ToolBadge is the join table for Tool-Badge many2many with extra field
@@ -90,40 +102,121 @@ type ToolAlternative struct {
 
 // User represents a registered user
 type User struct {
-	ID           uint      `gorm:"primaryKey" json:"id"`
-	Email        string    `gorm:"uniqueIndex;not null" json:"email"`
-	PasswordHash string    `gorm:"not null" json:"-"` // Never expose password hash in JSON
-	DisplayName  string    `json:"display_name,omitempty"`
-	Role         string    `gorm:"type:varchar(50);not null;check:role IN ('user', 'admin', 'moderator');default:'user'" json:"role"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID               uint       `gorm:"primaryKey" json:"id"`
+	Email            string     `gorm:"uniqueIndex;not null" json:"email"`
+	PasswordHash     string     `gorm:"not null" json:"-"` // Never expose password hash in JSON
+	DisplayName      string     `json:"display_name,omitempty"`
+	Role             string     `gorm:"type:varchar(50);not null;check:role IN ('user', 'admin', 'moderator');default:'user'" json:"role"`
+	SuspendedAt      *time.Time `json:"-"`
+	SuspendedBy      *uint      `json:"-"`
+	SuspensionReason string     `gorm:"type:text" json:"-"`
+	LastLoginAt      *time.Time `json:"-"`
+	EmailVerifiedAt  *time.Time `json:"-"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// Session is a logged-in device. Only a hash of its current refresh token is stored;
+// the previous hash is kept to detect reuse of a rotated-out token.
+type Session struct {
+	ID                uint       `gorm:"primaryKey" json:"id"`
+	UserID            uint       `gorm:"not null;index" json:"user_id"`
+	RefreshTokenHash  string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	PreviousTokenHash string     `gorm:"type:varchar(64)" json:"-"`
+	UserAgent         string     `gorm:"type:text" json:"user_agent,omitempty"`
+	IPAddress         string     `gorm:"type:varchar(45)" json:"ip_address,omitempty"`
+	ExpiresAt         time.Time  `gorm:"not null" json:"expires_at"`
+	LastUsedAt        time.Time  `json:"last_used_at"`
+	RevokedAt         *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// AccountToken is a single-use, expiring token emailed to a user to reset their password
+// or verify their email address. Only its hash is stored.
+type AccountToken struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"not null" json:"user_id"`
+	Purpose   string     `gorm:"type:varchar(50);not null;check:purpose IN ('password_reset', 'email_verification')" json:"purpose"`
+	TokenHash string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// LoginAttempt is an audit record of a login attempt. Recent failures are also used
+// to throttle guessing per email address and per client IP.
+type LoginAttempt struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	Email         string    `gorm:"type:varchar(255);not null" json:"email"`
+	UserID        *uint     `json:"user_id,omitempty"`
+	IPAddress     string    `gorm:"type:varchar(45)" json:"ip_address,omitempty"`
+	UserAgent     string    `gorm:"type:text" json:"user_agent,omitempty"`
+	Success       bool      `gorm:"not null" json:"success"`
+	FailureReason *string   `gorm:"type:varchar(50);check:failure_reason IN ('invalid_credentials', 'suspended', 'throttled', 'pending')" json:"failure_reason,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 // Review represents a user review of a tool
 type Review struct {
-	ID               uint       `gorm:"primaryKey" json:"id"`
-	ToolID           uint       `gorm:"not null" json:"tool_id"`
-	Tool             Tool       `gorm:"foreignKey:ToolID" json:"tool,omitempty"`
-	UserID           uint       `gorm:"not null" json:"user_id"`
-	User             User       `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	RatingOverall    int        `gorm:"not null;check:rating_overall >= 1 AND rating_overall <= 5" json:"rating_overall"`
-	RatingEaseOfUse  *int       `gorm:"check:rating_ease_of_use >= 1 AND rating_ease_of_use <= 5" json:"rating_ease_of_use,omitempty"`
-	RatingValue      *int       `gorm:"check:rating_value >= 1 AND rating_value <= 5" json:"rating_value,omitempty"`
-	RatingAccuracy   *int       `gorm:"check:rating_accuracy >= 1 AND rating_accuracy <= 5" json:"rating_accuracy,omitempty"`
-	RatingSpeed      *int       `gorm:"check:rating_speed >= 1 AND rating_speed <= 5" json:"rating_speed,omitempty"`
-	RatingSupport    *int       `gorm:"check:rating_support >= 1 AND rating_support <= 5" json:"rating_support,omitempty"`
-	Pros             string     `gorm:"type:text" json:"pros,omitempty"`
-	Cons             string     `gorm:"type:text" json:"cons,omitempty"`
-	PrimaryUseCase   string     `json:"primary_use_case,omitempty"`
-	ReviewerRole     string     `json:"reviewer_role,omitempty"`
-	CompanySize      string     `json:"company_size,omitempty"`
-	UsageContext     string     `gorm:"type:text" json:"usage_context,omitempty"`
-	HelpfulCount     int        `gorm:"default:0" json:"helpful_count"`
-	ModerationStatus string     `gorm:"type:varchar(50);not null;check:moderation_status IN ('pending', 'approved', 'rejected', 'hidden', 'removed');default:'pending'" json:"moderation_status"`
-	ModeratedBy      *uint      `json:"moderated_by,omitempty"`
-	ModeratedAt      *time.Time `json:"moderated_at,omitempty"`
-	CreatedAt        time.Time  `json:"created_at"`
-	UpdatedAt        time.Time  `json:"updated_at"`
+	ID               uint            `gorm:"primaryKey" json:"id"`
+	ToolID           uint            `gorm:"not null" json:"tool_id"`
+	Tool             Tool            `gorm:"foreignKey:ToolID" json:"tool,omitempty"`
+	UserID           uint            `gorm:"not null" json:"user_id"`
+	User             User            `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	RatingOverall    int             `gorm:"not null;check:rating_overall >= 1 AND rating_overall <= 5" json:"rating_overall"`
+	RatingEaseOfUse  *int            `gorm:"check:rating_ease_of_use >= 1 AND rating_ease_of_use <= 5" json:"rating_ease_of_use,omitempty"`
+	RatingValue      *int            `gorm:"check:rating_value >= 1 AND rating_value <= 5" json:"rating_value,omitempty"`
+	RatingAccuracy   *int            `gorm:"check:rating_accuracy >= 1 AND rating_accuracy <= 5" json:"rating_accuracy,omitempty"`
+	RatingSpeed      *int            `gorm:"check:rating_speed >= 1 AND rating_speed <= 5" json:"rating_speed,omitempty"`
+	RatingSupport    *int            `gorm:"check:rating_support >= 1 AND rating_support <= 5" json:"rating_support,omitempty"`
+	Pros             string          `gorm:"type:text" json:"pros,omitempty"`
+	Cons             string          `gorm:"type:text" json:"cons,omitempty"`
+	PrimaryUseCase   string          `json:"primary_use_case,omitempty"`
+	ReviewerRole     string          `json:"reviewer_role,omitempty"`
+	CompanySize      string          `json:"company_size,omitempty"`
+	UsageContext     string          `gorm:"type:text" json:"usage_context,omitempty"`
+	HelpfulCount     int             `gorm:"default:0" json:"helpful_count"`
+	NotHelpfulCount  int             `gorm:"default:0" json:"not_helpful_count"`
+	ModerationStatus string          `gorm:"type:varchar(50);not null;check:moderation_status IN ('pending', 'approved', 'rejected', 'hidden', 'removed', 'deleted');default:'pending'" json:"moderation_status"`
+	ModeratedBy      *uint           `json:"moderated_by,omitempty"`
+	ModeratedAt      *time.Time      `json:"moderated_at,omitempty"`
+	EditedAt         *time.Time      `json:"edited_at,omitempty"`
+	EditCount        int             `gorm:"not null;default:0" json:"edit_count"`
+	VendorResponse   *VendorResponse `gorm:"foreignKey:ReviewID" json:"vendor_response,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// ReviewEdit is a snapshot of a review taken just before its author edited it
+type ReviewEdit struct {
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	ReviewID         uint      `gorm:"not null" json:"review_id"`
+	UserID           uint      `gorm:"not null" json:"user_id"`
+	RatingOverall    int       `gorm:"not null" json:"rating_overall"`
+	RatingEaseOfUse  *int      `json:"rating_ease_of_use,omitempty"`
+	RatingValue      *int      `json:"rating_value,omitempty"`
+	RatingAccuracy   *int      `json:"rating_accuracy,omitempty"`
+	RatingSpeed      *int      `json:"rating_speed,omitempty"`
+	RatingSupport    *int      `json:"rating_support,omitempty"`
+	Pros             string    `gorm:"type:text" json:"pros,omitempty"`
+	Cons             string    `gorm:"type:text" json:"cons,omitempty"`
+	PrimaryUseCase   string    `json:"primary_use_case,omitempty"`
+	ReviewerRole     string    `json:"reviewer_role,omitempty"`
+	CompanySize      string    `json:"company_size,omitempty"`
+	UsageContext     string    `gorm:"type:text" json:"usage_context,omitempty"`
+	ModerationStatus string    `gorm:"type:varchar(50);not null" json:"moderation_status"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// ReviewVote is a user's or anonymous session's helpful / not helpful vote on a review
+type ReviewVote struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	ReviewID  uint      `gorm:"not null" json:"review_id"`
+	UserID    *uint     `json:"user_id,omitempty"`
+	SessionID *string   `gorm:"type:varchar(255)" json:"session_id,omitempty"` // NULL for logged-in voters
+	Helpful   bool      `gorm:"not null" json:"helpful"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 // Bookmark represents a user's saved tool
@@ -143,24 +236,66 @@ type Report struct {
 	ReportableID   uint       `gorm:"not null" json:"reportable_id"`
 	ReporterUserID *uint      `json:"reporter_user_id,omitempty"`
 	ReporterUser   *User      `gorm:"foreignKey:ReporterUserID" json:"reporter_user,omitempty"`
-	Reason         string     `gorm:"type:varchar(50);not null;check:reason IN ('spam', 'abuse', 'misinformation', 'other')" json:"reason"`
+	Reason         string     `gorm:"type:varchar(50);not null;check:reason IN ('spam', 'abuse', 'misinformation', 'other', 'pre_moderation')" json:"reason"`
 	Comment        string     `gorm:"type:text" json:"comment,omitempty"`
 	Status         string     `gorm:"type:varchar(50);not null;check:status IN ('pending', 'reviewed', 'dismissed');default:'pending'" json:"status"`
 	ReviewedBy     *uint      `json:"reviewed_by,omitempty"`
 	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
+	EscalatedAt    *time.Time `json:"escalated_at,omitempty"`
 	CreatedAt      time.Time  `json:"created_at"`
 }
 
 // ModerationAction represents an audit log of moderation actions
 type ModerationAction struct {
-	ID          uint      `gorm:"primaryKey" json:"id"`
-	ReviewID    uint      `gorm:"not null" json:"review_id"`
-	Review      Review    `gorm:"foreignKey:ReviewID" json:"-"`
-	ModeratorID uint      `gorm:"not null" json:"moderator_id"`
-	Moderator   User      `gorm:"foreignKey:ModeratorID" json:"moderator,omitempty"`
-	ActionType  string    `gorm:"type:varchar(50);not null;check:action_type IN ('approve', 'hide', 'remove', 'restore')" json:"action_type"`
-	Notes       string    `gorm:"type:text" json:"notes,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	ReviewID         *uint     `json:"review_id,omitempty"`
+	Review           Review    `gorm:"foreignKey:ReviewID" json:"-"`
+	ToolID           *uint     `json:"tool_id,omitempty"`
+	ModeratorID      *uint     `json:"moderator_id,omitempty"` // Nil for automatic system actions
+	Moderator        User      `gorm:"foreignKey:ModeratorID" json:"moderator,omitempty"`
+	ActionType       string    `gorm:"type:varchar(50);not null;check:action_type IN ('approve', 'reject', 'hide', 'remove', 'restore', 'flag', 'delete', 'unflag')" json:"action_type"`
+	FromStatus       string    `gorm:"type:varchar(50)" json:"from_status,omitempty"`
+	ToStatus         string    `gorm:"type:varchar(50)" json:"to_status,omitempty"`
+	VendorResponseID *uint     `json:"vendor_response_id,omitempty"`
+	Notes            string    `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// ToolEvent is an append-only engagement event: a profile view or a click-out to the tool's site
+type ToolEvent struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	ToolID    uint      `gorm:"not null" json:"tool_id"`
+	EventType string    `gorm:"type:varchar(20);not null;check:event_type IN ('view', 'click_out')" json:"event_type"`
+	UserID    *uint     `json:"user_id,omitempty"`
+	SessionID string    `gorm:"type:varchar(255)" json:"session_id,omitempty"`
+	Referrer  string    `gorm:"type:text" json:"referrer,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ToolOwner marks a user an admin has verified as a representative of a tool's vendor
+type ToolOwner struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	ToolID     uint      `gorm:"not null;uniqueIndex:idx_tool_owners_tool_user" json:"tool_id"`
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_tool_owners_tool_user" json:"user_id"`
+	User       User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	VerifiedBy *uint     `json:"verified_by,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// VendorResponse is a tool owner's public reply to a review.
+// It is moderated like a review and only shown once approved.
+type VendorResponse struct {
+	ID               uint       `gorm:"primaryKey" json:"id"`
+	ReviewID         uint       `gorm:"not null;uniqueIndex" json:"review_id"`
+	ToolID           uint       `gorm:"not null" json:"tool_id"`
+	UserID           uint       `gorm:"not null" json:"user_id"`
+	User             User       `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Body             string     `gorm:"type:text;not null" json:"body"`
+	ModerationStatus string     `gorm:"type:varchar(50);not null;check:moderation_status IN ('pending', 'approved', 'rejected', 'hidden', 'removed');default:'pending'" json:"moderation_status"`
+	ModeratedBy      *uint      `json:"moderated_by,omitempty"`
+	ModeratedAt      *time.Time `json:"moderated_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
 }
 
 // TableName overrides for GORM
@@ -172,7 +307,15 @@ func (Tool) TableName() string             { return "tools" }
 func (ToolBadge) TableName() string        { return "tool_badges" }
 func (ToolAlternative) TableName() string  { return "tool_alternatives" }
 func (User) TableName() string             { return "users" }
+func (Session) TableName() string          { return "sessions" }
+func (AccountToken) TableName() string     { return "account_tokens" }
+func (LoginAttempt) TableName() string     { return "login_attempts" }
 func (Review) TableName() string           { return "reviews" }
 func (Bookmark) TableName() string         { return "bookmarks" }
 func (Report) TableName() string           { return "reports" }
 func (ModerationAction) TableName() string { return "moderation_actions" }
+func (ToolEvent) TableName() string        { return "tool_events" }
+func (ReviewVote) TableName() string       { return "review_votes" }
+func (ReviewEdit) TableName() string       { return "review_edits" }
+func (ToolOwner) TableName() string        { return "tool_owners" }
+func (VendorResponse) TableName() string   { return "vendor_responses" }
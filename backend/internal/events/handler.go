@@ -0,0 +1,91 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/requests"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
+)
+
+// Handler handles HTTP requests for engagement events
+type Handler struct {
+	service Service
+}
+
+// NewHandler creates a new events handler
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers event routes on the given router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup, optionalAuthMiddleware gin.HandlerFunc) {
+	rg.POST("/events", optionalAuthMiddleware, h.RecordEvent)
+	rg.GET("/tools/:slug/visit", optionalAuthMiddleware, h.Visit)
+}
+
+// RecordEvent handles POST /api/v1/events
+func (h *Handler) RecordEvent(c *gin.Context) {
+	var req RecordEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "event_type is required", nil)
+		return
+	}
+	if req.Referrer == "" {
+		req.Referrer = c.Request.Referer()
+	}
+
+	userID, sessionID := h.getUserOrSession(c)
+
+	if err := h.service.RecordEvent(req, userID, sessionID); err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidEventType):
+			responses.Error(c, http.StatusBadRequest, "INVALID_EVENT_TYPE", "event_type must be view", nil)
+		case errors.Is(err, ErrToolRequired):
+			responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "tool_id or tool_slug is required", nil)
+		case errors.Is(err, ErrToolNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to record event", nil)
+		}
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// Visit handles GET /api/v1/tools/:slug/visit
+func (h *Handler) Visit(c *gin.Context) {
+	userID, sessionID := h.getUserOrSession(c)
+
+	target, err := h.service.Visit(c.Param("slug"), userID, sessionID, c.Request.Referer())
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrToolNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
+		case errors.Is(err, ErrNoOfficialURL):
+			responses.Error(c, http.StatusNotFound, "NO_OFFICIAL_URL", "Tool has no official URL", nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to resolve tool", nil)
+		}
+		return
+	}
+
+	c.Redirect(http.StatusFound, target)
+}
+
+// getUserOrSession extracts user_id from auth context or session_id from cookie,
+// issuing a session cookie to anonymous visitors so their events can be grouped
+func (h *Handler) getUserOrSession(c *gin.Context) (uint, string) {
+	if userIDVal, exists := c.Get("user_id"); exists {
+		if userID, ok := userIDVal.(uint); ok && userID > 0 {
+			return userID, ""
+		}
+	}
+
+	if sessionID, err := c.Cookie(requests.SessionCookie); err == nil && sessionID != "" {
+		return 0, sessionID
+	}
+	return 0, requests.NewSessionID(c)
+}
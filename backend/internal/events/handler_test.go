@@ -0,0 +1,133 @@
+package events_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/events"
+)
+
+// MockService is a mock implementation of events.Service
+type MockService struct {
+	mock.Mock
+}
+
+func (m *MockService) RecordEvent(req events.RecordEventRequest, userID uint, sessionID string) error {
+	args := m.Called(req, userID, sessionID)
+	return args.Error(0)
+}
+
+func (m *MockService) Visit(slug string, userID uint, sessionID, referrer string) (string, error) {
+	args := m.Called(slug, userID, sessionID, referrer)
+	return args.String(0), args.Error(1)
+}
+
+func setupTestRouter(mockService *MockService, userID uint) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	optionalAuth := func(c *gin.Context) {
+		if userID > 0 {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	}
+	handler := events.NewHandler(mockService)
+	handler.RegisterRoutes(router.Group("/api/v1"), optionalAuth)
+	return router
+}
+
+func TestHandlerRecordEvent(t *testing.T) {
+	t.Run("accepts event and falls back to Referer header", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("RecordEvent", events.RecordEventRequest{
+			ToolSlug:  "chatgpt",
+			EventType: "view",
+			Referrer:  "https://news.example.com",
+		}, uint(0), "sess-1").Return(nil)
+
+		router := setupTestRouter(mockService, 0)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/events", bytes.NewBufferString(`{"tool_slug":"chatgpt","event_type":"view"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Referer", "https://news.example.com")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "sess-1"})
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("issues session cookie to new visitors", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("RecordEvent", mock.Anything, uint(0), mock.AnythingOfType("string")).Return(nil)
+
+		router := setupTestRouter(mockService, 0)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/events", bytes.NewBufferString(`{"tool_id":1,"event_type":"view"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "session_id=")
+	})
+
+	t.Run("returns 400 for invalid event type", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("RecordEvent", mock.Anything, uint(5), "").Return(events.ErrInvalidEventType)
+
+		router := setupTestRouter(mockService, 5)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/events", bytes.NewBufferString(`{"tool_id":1,"event_type":"purchase"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_EVENT_TYPE")
+	})
+
+	t.Run("returns 404 for unknown tool", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("RecordEvent", mock.Anything, uint(5), "").Return(events.ErrToolNotFound)
+
+		router := setupTestRouter(mockService, 5)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/events", bytes.NewBufferString(`{"tool_slug":"missing","event_type":"view"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestHandlerVisit(t *testing.T) {
+	t.Run("redirects to official URL", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("Visit", "chatgpt", uint(5), "", "").Return("https://chat.openai.com", nil)
+
+		router := setupTestRouter(mockService, 5)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools/chatgpt/visit", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://chat.openai.com", w.Header().Get("Location"))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 404 for unknown tool", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("Visit", "missing", uint(5), "", "").Return("", events.ErrToolNotFound)
+
+		router := setupTestRouter(mockService, 5)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools/missing/visit", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,25 @@
+package events
+
+import "github.com/your-org/ai-tools-atlas-backend/internal/domain"
+
+// ToolEvent is an alias for domain.ToolEvent
+type ToolEvent = domain.ToolEvent
+
+// Event types. Only views are accepted by POST /api/v1/events; click-outs are recorded by the
+// visit redirect, so clients cannot inflate them without actually following the link.
+const (
+	EventView     = "view"
+	EventClickOut = "click_out"
+)
+
+// maxReferrerLength caps stored referrers so a hostile client cannot bloat the table
+const maxReferrerLength = 2048
+
+// RecordEventRequest represents the request body for POST /api/v1/events.
+// The tool may be identified by either ID or slug.
+type RecordEventRequest struct {
+	ToolID    uint   `json:"tool_id"`
+	ToolSlug  string `json:"tool_slug"`
+	EventType string `json:"event_type" binding:"required"`
+	Referrer  string `json:"referrer"`
+}
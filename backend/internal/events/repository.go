@@ -0,0 +1,52 @@
+package events
+
+import (
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Repository defines the interface for event data operations
+type Repository interface {
+	GetToolByID(id uint) (*domain.Tool, error)
+	GetToolBySlug(slug string) (*domain.Tool, error)
+	CreateEvent(event *ToolEvent) error
+}
+
+// repository implements the Repository interface
+type repository struct {
+	db *gorm.DB
+}
+
+// NewRepository creates a new events repository
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// GetToolByID returns a non-archived tool by ID
+func (r *repository) GetToolByID(id uint) (*domain.Tool, error) {
+	var tool domain.Tool
+	err := r.db.Select("id", "slug", "official_url").
+		Where("id = ? AND archived_at IS NULL", id).
+		First(&tool).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tool, nil
+}
+
+// GetToolBySlug returns a non-archived tool by slug
+func (r *repository) GetToolBySlug(slug string) (*domain.Tool, error) {
+	var tool domain.Tool
+	err := r.db.Select("id", "slug", "official_url").
+		Where("slug = ? AND archived_at IS NULL", slug).
+		First(&tool).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tool, nil
+}
+
+// CreateEvent appends an event to the tool_events table
+func (r *repository) CreateEvent(event *ToolEvent) error {
+	return r.db.Create(event).Error
+}
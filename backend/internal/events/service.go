@@ -0,0 +1,135 @@
+package events
+
+import (
+	"errors"
+	"log"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Error constants
+var (
+	ErrToolNotFound     = errors.New("tool not found")
+	ErrToolRequired     = errors.New("tool_id or tool_slug is required")
+	ErrInvalidEventType = errors.New("invalid event type")
+	ErrNoOfficialURL    = errors.New("tool has no valid official URL")
+)
+
+// Service defines the interface for event ingestion business logic
+type Service interface {
+	RecordEvent(req RecordEventRequest, userID uint, sessionID string) error
+	Visit(slug string, userID uint, sessionID, referrer string) (string, error)
+}
+
+// service implements the Service interface
+type service struct {
+	repo Repository
+}
+
+// NewService creates a new events service
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+// RecordEvent validates and stores a single engagement event
+func (s *service) RecordEvent(req RecordEventRequest, userID uint, sessionID string) error {
+	if !ValidateEventType(req.EventType) {
+		return ErrInvalidEventType
+	}
+
+	tool, err := s.resolveTool(req.ToolID, strings.TrimSpace(req.ToolSlug))
+	if err != nil {
+		return err
+	}
+
+	return s.repo.CreateEvent(newEvent(tool.ID, req.EventType, userID, sessionID, req.Referrer))
+}
+
+// Visit records a click-out for the tool and returns the URL to redirect to.
+// A failure to store the event is logged but never blocks the redirect.
+func (s *service) Visit(slug string, userID uint, sessionID, referrer string) (string, error) {
+	tool, err := s.resolveTool(0, slug)
+	if err != nil {
+		return "", err
+	}
+
+	target, ok := redirectURL(tool.OfficialURL)
+	if !ok {
+		return "", ErrNoOfficialURL
+	}
+
+	if err := s.repo.CreateEvent(newEvent(tool.ID, EventClickOut, userID, sessionID, referrer)); err != nil {
+		log.Printf("Failed to record click-out for tool %d: %v", tool.ID, err)
+	}
+
+	return target, nil
+}
+
+// resolveTool looks up a tool by ID, falling back to slug
+func (s *service) resolveTool(id uint, slug string) (*domain.Tool, error) {
+	var (
+		tool *domain.Tool
+		err  error
+	)
+	switch {
+	case id > 0:
+		tool, err = s.repo.GetToolByID(id)
+	case slug != "":
+		tool, err = s.repo.GetToolBySlug(slug)
+	default:
+		return nil, ErrToolRequired
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrToolNotFound
+		}
+		return nil, err
+	}
+	return tool, nil
+}
+
+// newEvent builds an event, attributing it to the user when signed in and the session otherwise
+func newEvent(toolID uint, eventType string, userID uint, sessionID, referrer string) *ToolEvent {
+	event := &ToolEvent{
+		ToolID:    toolID,
+		EventType: eventType,
+		Referrer:  truncate(strings.TrimSpace(referrer), maxReferrerLength),
+	}
+	if userID > 0 {
+		event.UserID = &userID
+	} else {
+		event.SessionID = sessionID
+	}
+	return event
+}
+
+// ValidateEventType reports whether the event type is accepted by the ingestion API
+func ValidateEventType(eventType string) bool {
+	return eventType == EventView
+}
+
+// redirectURL returns the official URL if it is an absolute http(s) URL
+func redirectURL(raw string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", false
+	}
+	return u.String(), true
+}
+
+// truncate shortens s to at most n bytes, dropping invalid UTF-8 and never splitting a character
+func truncate(s string, n int) string {
+	s = strings.ToValidUTF8(s, "")
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,207 @@
+package events_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/events"
+	"gorm.io/gorm"
+)
+
+// MockRepository is a mock implementation of events.Repository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetToolByID(id uint) (*domain.Tool, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Tool), args.Error(1)
+}
+
+func (m *MockRepository) GetToolBySlug(slug string) (*domain.Tool, error) {
+	args := m.Called(slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Tool), args.Error(1)
+}
+
+func (m *MockRepository) CreateEvent(event *events.ToolEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+func TestServiceRecordEvent(t *testing.T) {
+	t.Run("records view for anonymous session", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(&domain.Tool{ID: 7, Slug: "chatgpt"}, nil)
+		mockRepo.On("CreateEvent", &events.ToolEvent{
+			ToolID:    7,
+			EventType: events.EventView,
+			SessionID: "sess-1",
+			Referrer:  "https://example.com",
+		}).Return(nil)
+
+		service := events.NewService(mockRepo)
+		err := service.RecordEvent(events.RecordEventRequest{
+			ToolSlug:  "chatgpt",
+			EventType: events.EventView,
+			Referrer:  "https://example.com",
+		}, 0, "sess-1")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("attributes event to signed-in user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByID", uint(7)).Return(&domain.Tool{ID: 7}, nil)
+		mockRepo.On("CreateEvent", mock.MatchedBy(func(e *events.ToolEvent) bool {
+			return e.UserID != nil && *e.UserID == 3 && e.SessionID == ""
+		})).Return(nil)
+
+		service := events.NewService(mockRepo)
+		err := service.RecordEvent(events.RecordEventRequest{ToolID: 7, EventType: events.EventView}, 3, "sess-1")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("truncates long referrers", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByID", uint(7)).Return(&domain.Tool{ID: 7}, nil)
+		mockRepo.On("CreateEvent", mock.MatchedBy(func(e *events.ToolEvent) bool {
+			return len(e.Referrer) == 2048
+		})).Return(nil)
+
+		service := events.NewService(mockRepo)
+		err := service.RecordEvent(events.RecordEventRequest{
+			ToolID:    7,
+			EventType: events.EventView,
+			Referrer:  strings.Repeat("a", 5000),
+		}, 0, "sess-1")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("truncates referrers without splitting characters", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByID", uint(7)).Return(&domain.Tool{ID: 7}, nil)
+		mockRepo.On("CreateEvent", mock.MatchedBy(func(e *events.ToolEvent) bool {
+			return utf8.ValidString(e.Referrer) && len(e.Referrer) <= 2048 && len(e.Referrer) > 2040
+		})).Return(nil)
+
+		service := events.NewService(mockRepo)
+		err := service.RecordEvent(events.RecordEventRequest{
+			ToolID:    7,
+			EventType: events.EventView,
+			Referrer:  "https://example.com/" + strings.Repeat("é", 2000),
+		}, 0, "sess-1")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("drops invalid UTF-8 from referrers", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByID", uint(7)).Return(&domain.Tool{ID: 7}, nil)
+		mockRepo.On("CreateEvent", mock.MatchedBy(func(e *events.ToolEvent) bool {
+			return e.Referrer == "https://example.com/"
+		})).Return(nil)
+
+		service := events.NewService(mockRepo)
+		err := service.RecordEvent(events.RecordEventRequest{
+			ToolID:    7,
+			EventType: events.EventView,
+			Referrer:  "https://example.com/\xff",
+		}, 0, "sess-1")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects click-outs, which only the visit redirect records", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := events.NewService(mockRepo)
+		err := service.RecordEvent(events.RecordEventRequest{ToolID: 7, EventType: events.EventClickOut}, 0, "sess-1")
+
+		assert.ErrorIs(t, err, events.ErrInvalidEventType)
+		mockRepo.AssertNotCalled(t, "CreateEvent", mock.Anything)
+	})
+
+	t.Run("rejects unknown event type", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := events.NewService(mockRepo)
+		err := service.RecordEvent(events.RecordEventRequest{ToolID: 7, EventType: "purchase"}, 0, "sess-1")
+
+		assert.ErrorIs(t, err, events.ErrInvalidEventType)
+		mockRepo.AssertNotCalled(t, "CreateEvent", mock.Anything)
+	})
+
+	t.Run("requires a tool", func(t *testing.T) {
+		service := events.NewService(new(MockRepository))
+		err := service.RecordEvent(events.RecordEventRequest{EventType: events.EventView}, 0, "sess-1")
+
+		assert.ErrorIs(t, err, events.ErrToolRequired)
+	})
+
+	t.Run("returns error for unknown tool", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "missing").Return(nil, gorm.ErrRecordNotFound)
+
+		service := events.NewService(mockRepo)
+		err := service.RecordEvent(events.RecordEventRequest{ToolSlug: "missing", EventType: events.EventView}, 0, "")
+
+		assert.ErrorIs(t, err, events.ErrToolNotFound)
+	})
+}
+
+func TestServiceVisit(t *testing.T) {
+	t.Run("logs click-out and returns official URL", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(&domain.Tool{ID: 7, OfficialURL: "https://chat.openai.com"}, nil)
+		mockRepo.On("CreateEvent", mock.MatchedBy(func(e *events.ToolEvent) bool {
+			return e.ToolID == 7 && e.EventType == events.EventClickOut
+		})).Return(nil)
+
+		service := events.NewService(mockRepo)
+		target, err := service.Visit("chatgpt", 0, "sess-1", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://chat.openai.com", target)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("redirects even when logging fails", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(&domain.Tool{ID: 7, OfficialURL: "https://chat.openai.com"}, nil)
+		mockRepo.On("CreateEvent", mock.Anything).Return(assert.AnError)
+
+		service := events.NewService(mockRepo)
+		target, err := service.Visit("chatgpt", 0, "sess-1", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://chat.openai.com", target)
+	})
+
+	t.Run("rejects non-http official URLs", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "shady").Return(&domain.Tool{ID: 8, OfficialURL: "javascript:alert(1)"}, nil)
+
+		service := events.NewService(mockRepo)
+		_, err := service.Visit("shady", 0, "sess-1", "")
+
+		assert.ErrorIs(t, err, events.ErrNoOfficialURL)
+		mockRepo.AssertNotCalled(t, "CreateEvent", mock.Anything)
+	})
+}
@@ -0,0 +1,198 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// MaxBulkItems is the maximum number of items a single bulk moderation call may act on
+const MaxBulkItems = 100
+
+var (
+	ErrInvalidBulkTarget = errors.New("target_type must be review or report")
+	ErrInvalidBulkAction = errors.New("action is not supported for this target type")
+	ErrNoBulkItems       = errors.New("ids must not be empty")
+	ErrTooManyBulkItems  = fmt.Errorf("ids must contain at most %d items", MaxBulkItems)
+	ErrReportResolved    = errors.New("report has already been resolved")
+	ErrNotReviewReport   = errors.New("action only applies to review reports")
+)
+
+// Valid bulk actions per target type
+var validBulkActions = map[string]map[string]bool{
+	"review": {"approve": true, "hide": true, "remove": true},
+	"report": {"approve": true, "hide": true, "remove": true, "dismiss": true},
+}
+
+// BulkModerationInput represents input for moderating many reviews or reports at once.
+// For reports, approve/hide/remove act on the reported review and mark the report reviewed.
+type BulkModerationInput struct {
+	TargetType string `json:"target_type" binding:"required"`
+	Action     string `json:"action" binding:"required"`
+	IDs        []uint `json:"ids"`
+	Notes      string `json:"notes,omitempty"`
+}
+
+// BulkItemResult is the outcome of a bulk action on a single item
+type BulkItemResult struct {
+	ID      uint   `json:"id"`
+	Success bool   `json:"success"`
+	Code    string `json:"code,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// BulkModerationResult summarises a bulk moderation call
+type BulkModerationResult struct {
+	Action     string           `json:"action"`
+	TargetType string           `json:"target_type"`
+	Succeeded  int              `json:"succeeded"`
+	Failed     int              `json:"failed"`
+	Results    []BulkItemResult `json:"results"`
+}
+
+// BulkModerate applies one moderation action to each listed review or report. Items are processed
+// independently; a failure on one item does not stop the others. Rating aggregates are recomputed
+// once per affected tool after all items are processed.
+func (s *service) BulkModerate(input BulkModerationInput, moderatorID uint) (*BulkModerationResult, error) {
+	actions, ok := validBulkActions[input.TargetType]
+	if !ok {
+		return nil, ErrInvalidBulkTarget
+	}
+	if !actions[input.Action] {
+		return nil, ErrInvalidBulkAction
+	}
+
+	ids := uniqueIDs(input.IDs)
+	if len(ids) == 0 {
+		return nil, ErrNoBulkItems
+	}
+	if len(ids) > MaxBulkItems {
+		return nil, ErrTooManyBulkItems
+	}
+
+	result := &BulkModerationResult{
+		Action:     input.Action,
+		TargetType: input.TargetType,
+		Results:    make([]BulkItemResult, 0, len(ids)),
+	}
+	actionInput := ModerationActionInput{Notes: input.Notes}
+	affectedTools := make(map[uint]bool)
+	var toolOrder []uint
+
+	for _, id := range ids {
+		var toolID uint
+		var ratingsChanged bool
+		var err error
+
+		if input.TargetType == "review" {
+			toolID, ratingsChanged, err = s.applyReviewAction(id, &moderatorID, input.Action, actionInput)
+		} else {
+			toolID, ratingsChanged, err = s.applyReportAction(id, moderatorID, input.Action, actionInput)
+		}
+
+		if err != nil {
+			result.Failed++
+			result.Results = append(result.Results, bulkItemError(id, err))
+			continue
+		}
+
+		result.Succeeded++
+		result.Results = append(result.Results, BulkItemResult{ID: id, Success: true})
+		if ratingsChanged && !affectedTools[toolID] {
+			affectedTools[toolID] = true
+			toolOrder = append(toolOrder, toolID)
+		}
+	}
+
+	for _, toolID := range toolOrder {
+		s.updateRatingAggregates(toolID)
+	}
+
+	return result, nil
+}
+
+// applyReportAction resolves a report. dismiss closes the report without touching the reported item;
+// other actions moderate the reported review and mark the report reviewed.
+func (s *service) applyReportAction(reportID uint, moderatorID uint, actionType string, input ModerationActionInput) (uint, bool, error) {
+	report, err := s.repo.GetReportByID(reportID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, ErrReportNotFound
+		}
+		return 0, false, err
+	}
+	if report.Status != "pending" {
+		return 0, false, ErrReportResolved
+	}
+
+	if actionType == "dismiss" {
+		return 0, false, s.repo.UpdateReportStatus(reportID, "dismissed", moderatorID)
+	}
+
+	if report.ReportableType != "review" {
+		return 0, false, ErrNotReviewReport
+	}
+
+	review, err := s.repo.GetReviewByID(report.ReportableID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, ErrReviewNotFound
+		}
+		return 0, false, err
+	}
+
+	// Several reports often point at the same review; only the first one moves it
+	var action *domain.ModerationAction
+	if review.ModerationStatus != reviewActionTargets[actionType] {
+		action, err = s.buildReviewAction(review, &moderatorID, actionType, input)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	// Moderate the review and mark the report reviewed together
+	if err := s.repo.ResolveReviewReport(reportID, moderatorID, action); err != nil {
+		if errors.Is(err, ErrInvalidTransition) {
+			return 0, false, fmt.Errorf("%w: review status changed concurrently", ErrInvalidTransition)
+		}
+		return 0, false, err
+	}
+	if action == nil {
+		return 0, false, nil
+	}
+	return review.ToolID, changesRatings(action), nil
+}
+
+// bulkItemError converts an item failure into a result with an error code
+func bulkItemError(id uint, err error) BulkItemResult {
+	item := BulkItemResult{ID: id, Error: err.Error()}
+	switch {
+	case errors.Is(err, ErrReviewNotFound), errors.Is(err, ErrReportNotFound):
+		item.Code = "NOT_FOUND"
+	case errors.Is(err, ErrInvalidTransition):
+		item.Code = "INVALID_TRANSITION"
+	case errors.Is(err, ErrReportResolved):
+		item.Code = "ALREADY_RESOLVED"
+	case errors.Is(err, ErrNotReviewReport):
+		item.Code = "INVALID_ACTION"
+	default:
+		item.Code = "INTERNAL_ERROR"
+		item.Error = "failed to moderate item"
+	}
+	return item
+}
+
+// uniqueIDs drops duplicate IDs, keeping the first occurrence of each
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,69 @@
+package moderation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+)
+
+// EscalationConfig controls automatic escalation of items that pile up reports
+type EscalationConfig struct {
+	Thresholds map[string]int // Signed-in reporters per reason within Window that trigger escalation
+	Window     time.Duration  // Only reports newer than this count towards a threshold
+}
+
+// escalateIfNeeded checks whether a new report pushes its item over the threshold for its reason.
+// Only reports from signed-in users count, one per reporter, since anyone can report anonymously.
+// If so, the item's pending reports move to the top of the queue and, in the same transaction, an
+// approved review is hidden or a tool is flagged, each recorded as a system moderation action.
+func (s *service) escalateIfNeeded(report *Report) error {
+	threshold := s.escalation.Thresholds[report.Reason]
+	if threshold <= 0 || s.escalation.Window <= 0 || report.ReporterUserID == nil {
+		return nil
+	}
+
+	since := time.Now().Add(-s.escalation.Window)
+	count, err := s.repo.CountUnescalatedReporters(report.ReportableType, report.ReportableID, report.Reason, since)
+	if err != nil {
+		return err
+	}
+	if count < int64(threshold) {
+		return nil
+	}
+
+	notes := fmt.Sprintf("Auto-escalated: %d users reported %s within %s", count, report.Reason, s.escalation.Window)
+	switch report.ReportableType {
+	case "review":
+		return s.escalateReview(report.ReportableID, notes)
+	case "tool":
+		return s.repo.EscalateReports(report.ReportableType, report.ReportableID, &domain.ModerationAction{
+			ToolID:     &report.ReportableID,
+			ActionType: "flag",
+			Notes:      notes,
+		})
+	}
+	return s.repo.EscalateReports(report.ReportableType, report.ReportableID, nil)
+}
+
+// escalateReview escalates a review's reports and hides it on behalf of the system if it is
+// approved. Reviews in any other status are already out of public view and are left for a moderator.
+func (s *service) escalateReview(reviewID uint, notes string) error {
+	review, err := s.repo.GetReviewByID(reviewID)
+	if err != nil {
+		return err
+	}
+	if review.ModerationStatus != "approved" {
+		return s.repo.EscalateReports("review", reviewID, nil)
+	}
+
+	action, err := s.buildReviewAction(review, nil, "hide", ModerationActionInput{Notes: notes})
+	if err != nil {
+		return err
+	}
+	if err := s.repo.EscalateReports("review", reviewID, action); err != nil {
+		return err
+	}
+	s.updateRatingAggregates(review.ToolID)
+	return nil
+}
@@ -44,8 +44,24 @@ func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
 
 		// Review moderation actions
 		moderation.PATCH("/reviews/:id/approve", h.ApproveReview)
+		moderation.PATCH("/reviews/:id/reject", h.RejectReview)
 		moderation.PATCH("/reviews/:id/hide", h.HideReview)
 		moderation.PATCH("/reviews/:id/remove", h.RemoveReview)
+		moderation.PATCH("/reviews/:id/restore", h.RestoreReview)
+
+		// Tool moderation actions
+		moderation.PATCH("/tools/:id/unflag", h.UnflagTool)
+
+		// Vendor response moderation actions
+		moderation.GET("/responses", h.ListVendorResponses)
+		moderation.PATCH("/responses/:id/approve", h.ApproveVendorResponse)
+		moderation.PATCH("/responses/:id/reject", h.RejectVendorResponse)
+		moderation.PATCH("/responses/:id/hide", h.HideVendorResponse)
+		moderation.PATCH("/responses/:id/remove", h.RemoveVendorResponse)
+		moderation.PATCH("/responses/:id/restore", h.RestoreVendorResponse)
+
+		// Bulk actions on reviews or reports
+		moderation.POST("/bulk", h.BulkModerate)
 
 		// Moderation history
 		moderation.GET("/history/:review_id", h.GetModerationHistory)
@@ -252,6 +268,11 @@ func (h *Handler) ApproveReview(c *gin.Context) {
 	h.handleReviewModeration(c, "approve")
 }
 
+// RejectReview handles PATCH /api/v1/admin/moderation/reviews/:id/reject
+func (h *Handler) RejectReview(c *gin.Context) {
+	h.handleReviewModeration(c, "reject")
+}
+
 // HideReview handles PATCH /api/v1/admin/moderation/reviews/:id/hide
 func (h *Handler) HideReview(c *gin.Context) {
 	h.handleReviewModeration(c, "hide")
@@ -262,6 +283,11 @@ func (h *Handler) RemoveReview(c *gin.Context) {
 	h.handleReviewModeration(c, "remove")
 }
 
+// RestoreReview handles PATCH /api/v1/admin/moderation/reviews/:id/restore
+func (h *Handler) RestoreReview(c *gin.Context) {
+	h.handleReviewModeration(c, "restore")
+}
+
 func (h *Handler) handleReviewModeration(c *gin.Context, action string) {
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
@@ -290,16 +316,22 @@ func (h *Handler) handleReviewModeration(c *gin.Context, action string) {
 	switch action {
 	case "approve":
 		review, err = h.service.ApproveReview(uint(id), moderatorID, input)
+	case "reject":
+		review, err = h.service.RejectReview(uint(id), moderatorID, input)
 	case "hide":
 		review, err = h.service.HideReview(uint(id), moderatorID, input)
 	case "remove":
 		review, err = h.service.RemoveReview(uint(id), moderatorID, input)
+	case "restore":
+		review, err = h.service.RestoreReview(uint(id), moderatorID, input)
 	}
 
 	if err != nil {
 		switch {
 		case errors.Is(err, ErrReviewNotFound):
 			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Review not found", nil)
+		case errors.Is(err, ErrInvalidTransition):
+			responses.Error(c, http.StatusConflict, "INVALID_TRANSITION", err.Error(), nil)
 		default:
 			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to "+action+" review", nil)
 		}
@@ -309,6 +341,182 @@ func (h *Handler) handleReviewModeration(c *gin.Context, action string) {
 	responses.Success(c, review)
 }
 
+// UnflagTool handles PATCH /api/v1/admin/moderation/tools/:id/unflag
+func (h *Handler) UnflagTool(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_ID", "Invalid tool ID", nil)
+		return
+	}
+
+	var input ModerationActionInput
+	// Input is optional, so we don't fail on binding error
+	_ = c.ShouldBindJSON(&input)
+
+	// Get moderator's user ID
+	moderatorIDVal, exists := c.Get("user_id")
+	if !exists {
+		responses.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+	moderatorID, ok := moderatorIDVal.(uint)
+	if !ok {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Invalid user context", nil)
+		return
+	}
+
+	tool, err := h.service.UnflagTool(uint(id), moderatorID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrToolNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
+		case errors.Is(err, ErrInvalidTransition):
+			responses.Error(c, http.StatusConflict, "INVALID_TRANSITION", err.Error(), nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to unflag tool", nil)
+		}
+		return
+	}
+
+	responses.Success(c, tool)
+}
+
+// ListVendorResponses handles GET /api/v1/admin/moderation/responses
+func (h *Handler) ListVendorResponses(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+
+	vendorResponses, total, err := h.service.ListVendorResponses(c.DefaultQuery("status", "pending"), page, pageSize)
+	if err != nil {
+		if errors.Is(err, ErrInvalidModerationStatus) {
+			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid moderation status", nil)
+			return
+		}
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch vendor responses", nil)
+		return
+	}
+
+	responses.List(c, vendorResponses, map[string]interface{}{
+		"page":      page,
+		"page_size": pageSize,
+		"total":     total,
+	})
+}
+
+// ApproveVendorResponse handles PATCH /api/v1/admin/moderation/responses/:id/approve
+func (h *Handler) ApproveVendorResponse(c *gin.Context) {
+	h.handleVendorResponseModeration(c, "approve")
+}
+
+// RejectVendorResponse handles PATCH /api/v1/admin/moderation/responses/:id/reject
+func (h *Handler) RejectVendorResponse(c *gin.Context) {
+	h.handleVendorResponseModeration(c, "reject")
+}
+
+// HideVendorResponse handles PATCH /api/v1/admin/moderation/responses/:id/hide
+func (h *Handler) HideVendorResponse(c *gin.Context) {
+	h.handleVendorResponseModeration(c, "hide")
+}
+
+// RemoveVendorResponse handles PATCH /api/v1/admin/moderation/responses/:id/remove
+func (h *Handler) RemoveVendorResponse(c *gin.Context) {
+	h.handleVendorResponseModeration(c, "remove")
+}
+
+// RestoreVendorResponse handles PATCH /api/v1/admin/moderation/responses/:id/restore
+func (h *Handler) RestoreVendorResponse(c *gin.Context) {
+	h.handleVendorResponseModeration(c, "restore")
+}
+
+func (h *Handler) handleVendorResponseModeration(c *gin.Context, action string) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_ID", "Invalid response ID", nil)
+		return
+	}
+
+	var input ModerationActionInput
+	// Input is optional, so we don't fail on binding error
+	_ = c.ShouldBindJSON(&input)
+
+	// Get moderator's user ID
+	moderatorIDVal, exists := c.Get("user_id")
+	if !exists {
+		responses.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+	moderatorID, ok := moderatorIDVal.(uint)
+	if !ok {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Invalid user context", nil)
+		return
+	}
+
+	var response interface{}
+	switch action {
+	case "approve":
+		response, err = h.service.ApproveVendorResponse(uint(id), moderatorID, input)
+	case "reject":
+		response, err = h.service.RejectVendorResponse(uint(id), moderatorID, input)
+	case "hide":
+		response, err = h.service.HideVendorResponse(uint(id), moderatorID, input)
+	case "remove":
+		response, err = h.service.RemoveVendorResponse(uint(id), moderatorID, input)
+	case "restore":
+		response, err = h.service.RestoreVendorResponse(uint(id), moderatorID, input)
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrResponseNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Vendor response not found", nil)
+		case errors.Is(err, ErrInvalidTransition):
+			responses.Error(c, http.StatusConflict, "INVALID_TRANSITION", err.Error(), nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to "+action+" vendor response", nil)
+		}
+		return
+	}
+
+	responses.Success(c, response)
+}
+
+// BulkModerate handles POST /api/v1/admin/moderation/bulk
+func (h *Handler) BulkModerate(c *gin.Context) {
+	var input BulkModerationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	// Get moderator's user ID
+	moderatorIDVal, exists := c.Get("user_id")
+	if !exists {
+		responses.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+	moderatorID, ok := moderatorIDVal.(uint)
+	if !ok {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Invalid user context", nil)
+		return
+	}
+
+	result, err := h.service.BulkModerate(input, moderatorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidBulkTarget), errors.Is(err, ErrInvalidBulkAction),
+			errors.Is(err, ErrNoBulkItems), errors.Is(err, ErrTooManyBulkItems):
+			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error(), nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to apply bulk moderation", nil)
+		}
+		return
+	}
+
+	responses.Success(c, result)
+}
+
 // GetModerationHistory handles GET /api/v1/admin/moderation/history/:review_id
 func (h *Handler) GetModerationHistory(c *gin.Context) {
 	idParam := c.Param("review_id")
@@ -0,0 +1,88 @@
+package moderation_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/moderation"
+)
+
+func setupTestRouter(repo moderation.Repository) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	admin := r.Group("/api/v1/admin")
+	admin.Use(func(c *gin.Context) {
+		c.Set("user_id", uint(1))
+		c.Next()
+	})
+
+	handler := moderation.NewHandler(moderation.NewService(repo, nil), nil)
+	handler.RegisterAdminRoutes(admin)
+	return r
+}
+
+func TestModerationInvalidTransitions(t *testing.T) {
+	t.Run("returns 409 for a review action the status does not allow", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "pending"), nil)
+
+		router := setupTestRouter(mockRepo)
+		req, _ := http.NewRequest("PATCH", "/api/v1/admin/moderation/reviews/10/hide", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_TRANSITION")
+		mockRepo.AssertNotCalled(t, "TransitionReviewStatus", mock.Anything)
+	})
+
+	t.Run("returns 409 for a vendor response action the status does not allow", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetVendorResponseByID", uint(5)).Return(&domain.VendorResponse{ID: 5, ReviewID: 10, ModerationStatus: "removed"}, nil)
+
+		router := setupTestRouter(mockRepo)
+		req, _ := http.NewRequest("PATCH", "/api/v1/admin/moderation/responses/5/approve", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_TRANSITION")
+		mockRepo.AssertNotCalled(t, "TransitionVendorResponseStatus", mock.Anything)
+	})
+
+	t.Run("returns 409 when unflagging a tool that is not flagged", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByID", uint(4)).Return(&domain.Tool{ID: 4}, nil)
+		mockRepo.On("UnflagTool", mock.Anything).Return(moderation.ErrInvalidTransition)
+
+		router := setupTestRouter(mockRepo)
+		req, _ := http.NewRequest("PATCH", "/api/v1/admin/moderation/tools/4/unflag", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestListReportsShowsToolFlag(t *testing.T) {
+	flaggedAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	mockRepo := new(MockRepository)
+	mockRepo.On("ListReports", moderation.ReportFilters{Status: "pending"}, 1, 20).Return([]moderation.Report{
+		{ID: 1, ReportableType: "tool", ReportableID: 4, Reason: "spam", Status: "pending"},
+	}, int64(1), nil)
+	mockRepo.On("GetToolByID", uint(4)).Return(&domain.Tool{ID: 4, Name: "Spammy", FlaggedAt: &flaggedAt}, nil)
+
+	router := setupTestRouter(mockRepo)
+	req, _ := http.NewRequest("GET", "/api/v1/admin/moderation/queue", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"flagged_at":"2026-03-02T10:00:00Z"`)
+}
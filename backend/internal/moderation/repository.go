@@ -20,19 +20,29 @@ type Repository interface {
 	ListPendingReports(page, pageSize int) ([]Report, int64, error)
 	ListReports(filters ReportFilters, page, pageSize int) ([]Report, int64, error)
 	UpdateReportStatus(id uint, status string, reviewedBy uint) error
+	ResolveReviewReport(reportID uint, reviewedBy uint, action *domain.ModerationAction) error
 	CountUserReportsToday(userID uint, reportableType string, reportableID uint) (int64, error)
 	CountAnonReportsToday(reportableType string, reportableID uint) (int64, error)
+	CountUnescalatedReporters(reportableType string, reportableID uint, reason string, since time.Time) (int64, error)
+	EscalateReports(reportableType string, reportableID uint, action *domain.ModerationAction) error
 
 	// Review moderation
 	GetReviewByID(id uint) (*domain.Review, error)
-	UpdateReviewModerationStatus(id uint, status string, moderatorID uint) error
+	TransitionReviewStatus(action *domain.ModerationAction) error
+
+	// Vendor response moderation
+	GetVendorResponseByID(id uint) (*domain.VendorResponse, error)
+	ListVendorResponses(status string, page, pageSize int) ([]domain.VendorResponse, int64, error)
+	TransitionVendorResponseStatus(action *domain.ModerationAction) error
+	GetLastVendorResponseTransition(responseID uint, toStatus string) (*domain.ModerationAction, error)
 
 	// Moderation actions (audit log)
-	CreateModerationAction(action *domain.ModerationAction) error
 	GetModerationHistory(reviewID uint) ([]domain.ModerationAction, error)
+	GetLastReviewTransition(reviewID uint, toStatus string) (*domain.ModerationAction, error)
 
 	// Get reportable objects
 	GetToolByID(id uint) (*domain.Tool, error)
+	UnflagTool(action *domain.ModerationAction) error
 }
 
 // repository implements the Repository interface
@@ -90,7 +100,7 @@ func (r *repository) ListReports(filters ReportFilters, page, pageSize int) ([]R
 	err = r.db.
 		Preload("ReporterUser").
 		Where(r.buildWhereClause(filters)).
-		Order("created_at DESC").
+		Order("escalated_at IS NULL, escalated_at DESC, created_at DESC").
 		Offset(offset).
 		Limit(pageSize).
 		Find(&reports).Error
@@ -123,6 +133,37 @@ func (r *repository) UpdateReportStatus(id uint, status string, reviewedBy uint)
 	}).Error
 }
 
+// ResolveReviewReport marks a pending report reviewed and, if action is not nil, applies the
+// review transition in the same transaction. It returns ErrReportResolved if the report is no
+// longer pending and ErrInvalidTransition if the review is no longer in action.FromStatus.
+func (r *repository) ResolveReviewReport(reportID uint, reviewedBy uint, action *domain.ModerationAction) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if action != nil {
+			if err := transitionStatus(tx, &domain.Review{}, *action.ReviewID, action); err != nil {
+				return err
+			}
+			if err := resolveReviewHolds(tx, *action.ReviewID, action.ModeratorID); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&Report{}).
+			Where("id = ? AND status = ?", reportID, "pending").
+			Updates(map[string]interface{}{
+				"status":      "reviewed",
+				"reviewed_by": reviewedBy,
+				"reviewed_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrReportResolved
+		}
+		return nil
+	})
+}
+
 // CountUserReportsToday counts reports from a user for a specific item today
 func (r *repository) CountUserReportsToday(userID uint, reportableType string, reportableID uint) (int64, error) {
 	var count int64
@@ -145,6 +186,45 @@ func (r *repository) CountAnonReportsToday(reportableType string, reportableID u
 	return count, err
 }
 
+// CountUnescalatedReporters counts the distinct signed-in users with a pending report of a reason
+// for an item created since a time that has not already triggered an escalation. Anonymous reports
+// are not counted, so they cannot push an item over a threshold.
+func (r *repository) CountUnescalatedReporters(reportableType string, reportableID uint, reason string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&Report{}).
+		Where("reportable_type = ? AND reportable_id = ? AND reason = ? AND status = ? AND escalated_at IS NULL AND created_at >= ?",
+			reportableType, reportableID, reason, "pending", since).
+		Where("reporter_user_id IS NOT NULL").
+		Distinct("reporter_user_id").
+		Count(&count).Error
+	return count, err
+}
+
+// EscalateReports marks an item's pending reports as escalated so they lead the moderation queue.
+// If action is not nil it is applied in the same transaction: a review action moves the review,
+// a tool action flags the tool. Nothing is changed if the reports were already escalated.
+func (r *repository) EscalateReports(reportableType string, reportableID uint, action *domain.ModerationAction) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Report{}).
+			Where("reportable_type = ? AND reportable_id = ? AND status = ? AND escalated_at IS NULL", reportableType, reportableID, "pending").
+			Update("escalated_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 || action == nil {
+			return nil
+		}
+
+		if action.ToolID != nil {
+			return flagTool(tx, action)
+		}
+		if err := transitionStatus(tx, &domain.Review{}, *action.ReviewID, action); err != nil {
+			return err
+		}
+		return resolveReviewHolds(tx, *action.ReviewID, action.ModeratorID)
+	})
+}
+
 // GetReviewByID finds a review by ID
 func (r *repository) GetReviewByID(id uint) (*domain.Review, error) {
 	var review domain.Review
@@ -162,19 +242,35 @@ func (r *repository) GetReviewByID(id uint) (*domain.Review, error) {
 	return &review, nil
 }
 
-// UpdateReviewModerationStatus updates a review's moderation status
-func (r *repository) UpdateReviewModerationStatus(id uint, status string, moderatorID uint) error {
-	now := time.Now()
-	return r.db.Model(&domain.Review{}).Where("id = ?", id).Updates(map[string]interface{}{
-		"moderation_status": status,
-		"moderated_by":      moderatorID,
-		"moderated_at":      now,
-	}).Error
+// TransitionReviewStatus moves a review from action.FromStatus to action.ToStatus and records the
+// action in the same transaction. Pending pre-moderation holds on the review are resolved with it.
+// It returns ErrInvalidTransition if the review is no longer in action.FromStatus.
+func (r *repository) TransitionReviewStatus(action *domain.ModerationAction) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := transitionStatus(tx, &domain.Review{}, *action.ReviewID, action); err != nil {
+			return err
+		}
+		return resolveReviewHolds(tx, *action.ReviewID, action.ModeratorID)
+	})
 }
 
-// CreateModerationAction creates an audit log entry
-func (r *repository) CreateModerationAction(action *domain.ModerationAction) error {
-	return r.db.Create(action).Error
+// transitionStatus updates the moderation status of the row with the given ID if it is still in
+// action.FromStatus, then logs the action
+func transitionStatus(tx *gorm.DB, model interface{}, id uint, action *domain.ModerationAction) error {
+	result := tx.Model(model).
+		Where("id = ? AND moderation_status = ?", id, action.FromStatus).
+		Updates(map[string]interface{}{
+			"moderation_status": action.ToStatus,
+			"moderated_by":      action.ModeratorID,
+			"moderated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvalidTransition
+	}
+	return tx.Create(action).Error
 }
 
 // GetModerationHistory returns moderation actions for a review
@@ -193,6 +289,19 @@ func (r *repository) GetModerationHistory(reviewID uint) ([]domain.ModerationAct
 	return actions, nil
 }
 
+// GetLastReviewTransition returns the most recent non-restore action that moved a review into toStatus
+func (r *repository) GetLastReviewTransition(reviewID uint, toStatus string) (*domain.ModerationAction, error) {
+	var action domain.ModerationAction
+	err := r.db.
+		Where("review_id = ? AND vendor_response_id IS NULL AND to_status = ? AND action_type <> ?", reviewID, toStatus, "restore").
+		Order("created_at DESC, id DESC").
+		First(&action).Error
+	if err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
+
 // GetToolByID finds a tool by ID
 func (r *repository) GetToolByID(id uint) (*domain.Tool, error) {
 	var tool domain.Tool
@@ -204,3 +313,110 @@ func (r *repository) GetToolByID(id uint) (*domain.Tool, error) {
 	}
 	return &tool, nil
 }
+
+// flagTool flags a tool for moderator attention and records the action. Tools that are
+// already flagged are left as they are.
+func flagTool(tx *gorm.DB, action *domain.ModerationAction) error {
+	result := tx.Model(&domain.Tool{}).
+		Where("id = ? AND flagged_at IS NULL", *action.ToolID).
+		Update("flagged_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	return tx.Create(action).Error
+}
+
+// UnflagTool clears a tool's flag and records the action in the same transaction.
+// It returns ErrInvalidTransition if the tool is not flagged.
+func (r *repository) UnflagTool(action *domain.ModerationAction) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Tool{}).
+			Where("id = ? AND flagged_at IS NOT NULL", *action.ToolID).
+			Update("flagged_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidTransition
+		}
+		return tx.Create(action).Error
+	})
+}
+
+// resolveReviewHolds marks a review's pending pre-moderation holds as reviewed
+func resolveReviewHolds(tx *gorm.DB, reviewID uint, moderatorID *uint) error {
+	return tx.Model(&Report{}).
+		Where("reportable_type = ? AND reportable_id = ? AND reason = ? AND status = ?", "review", reviewID, "pre_moderation", "pending").
+		Updates(map[string]interface{}{
+			"status":      "reviewed",
+			"reviewed_by": moderatorID,
+			"reviewed_at": time.Now(),
+		}).Error
+}
+
+// GetVendorResponseByID finds a vendor response by ID
+func (r *repository) GetVendorResponseByID(id uint) (*domain.VendorResponse, error) {
+	var response domain.VendorResponse
+	err := r.db.
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "display_name", "email")
+		}).
+		Where("id = ?", id).First(&response).Error
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListVendorResponses returns vendor responses, optionally filtered by moderation status, oldest first
+func (r *repository) ListVendorResponses(status string, page, pageSize int) ([]domain.VendorResponse, int64, error) {
+	var responses []domain.VendorResponse
+	var total int64
+
+	query := r.db.Model(&domain.VendorResponse{})
+	if status != "" {
+		query = query.Where("moderation_status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "display_name", "email")
+		}).
+		Order("created_at ASC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&responses).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return responses, total, nil
+}
+
+// TransitionVendorResponseStatus moves a vendor response from action.FromStatus to action.ToStatus
+// and records the action in the same transaction
+func (r *repository) TransitionVendorResponseStatus(action *domain.ModerationAction) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return transitionStatus(tx, &domain.VendorResponse{}, *action.VendorResponseID, action)
+	})
+}
+
+// GetLastVendorResponseTransition returns the most recent non-restore action that moved a vendor response into toStatus
+func (r *repository) GetLastVendorResponseTransition(responseID uint, toStatus string) (*domain.ModerationAction, error) {
+	var action domain.ModerationAction
+	err := r.db.
+		Where("vendor_response_id = ? AND to_status = ? AND action_type <> ?", responseID, toStatus, "restore").
+		Order("created_at DESC, id DESC").
+		First(&action).Error
+	if err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
@@ -2,6 +2,9 @@ package moderation
 
 import (
 	"errors"
+	"fmt"
+	"log"
+	"time"
 
 	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
 	"gorm.io/gorm"
@@ -14,6 +17,9 @@ var (
 	ErrAlreadyReported       = errors.New("you have already reported this item today")
 	ErrInvalidStatus         = errors.New("invalid status")
 	ErrInvalidModerationStatus = errors.New("invalid moderation status")
+	ErrResponseNotFound      = errors.New("vendor response not found")
+	ErrInvalidTransition     = errors.New("moderation action is not allowed in the current status")
+	ErrToolNotFound          = errors.New("tool not found")
 )
 
 // Valid reasons for reporting
@@ -54,17 +60,32 @@ type ModerationActionInput struct {
 // ReportWithContext includes the reportable object
 type ReportWithContext struct {
 	Report
-	Tool   *domain.Tool   `json:"tool,omitempty"`
-	Review *domain.Review `json:"review,omitempty"`
+	Tool   *ModerationTool `json:"tool,omitempty"`
+	Review *domain.Review  `json:"review,omitempty"`
+}
+
+// ModerationTool is a tool as shown to moderators, including whether it is flagged
+type ModerationTool struct {
+	*domain.Tool
+	FlaggedAt *time.Time `json:"flagged_at,omitempty"`
+}
+
+// newModerationTool wraps a tool for moderator responses
+func newModerationTool(tool *domain.Tool) *ModerationTool {
+	return &ModerationTool{Tool: tool, FlaggedAt: tool.FlaggedAt}
 }
 
 // ModerationActionResponse represents an action in the history
 type ModerationActionResponse struct {
-	ID          uint   `json:"id"`
-	ActionType  string `json:"action_type"`
-	Notes       string `json:"notes,omitempty"`
-	CreatedAt   string `json:"created_at"`
-	Moderator   struct {
+	ID               uint   `json:"id"`
+	ActionType       string `json:"action_type"`
+	FromStatus       string `json:"from_status,omitempty"`
+	ToStatus         string `json:"to_status,omitempty"`
+	VendorResponseID *uint  `json:"vendor_response_id,omitempty"`
+	Notes            string `json:"notes,omitempty"`
+	System           bool   `json:"system"` // Taken automatically rather than by a moderator
+	CreatedAt        string `json:"created_at"`
+	Moderator        struct {
 		ID          uint   `json:"id"`
 		DisplayName string `json:"display_name"`
 	} `json:"moderator"`
@@ -81,9 +102,23 @@ type Service interface {
 
 	// Review moderation
 	ApproveReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error)
+	RejectReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error)
 	HideReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error)
 	RemoveReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error)
+	RestoreReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error)
 	GetModerationHistory(reviewID uint) ([]ModerationActionResponse, error)
+	BulkModerate(input BulkModerationInput, moderatorID uint) (*BulkModerationResult, error)
+
+	// Tool moderation
+	UnflagTool(toolID uint, moderatorID uint, input ModerationActionInput) (*ModerationTool, error)
+
+	// Vendor response moderation
+	ListVendorResponses(status string, page, pageSize int) ([]domain.VendorResponse, int64, error)
+	ApproveVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error)
+	RejectVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error)
+	HideVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error)
+	RemoveVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error)
+	RestoreVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error)
 }
 
 // ReviewsRepository is a subset of reviews.Repository needed for rating updates
@@ -95,11 +130,17 @@ type ReviewsRepository interface {
 type service struct {
 	repo        Repository
 	reviewsRepo ReviewsRepository
+	escalation  EscalationConfig
 }
 
-// NewService creates a new moderation service
+// NewService creates a new moderation service without report escalation
 func NewService(repo Repository, reviewsRepo ReviewsRepository) Service {
-	return &service{repo: repo, reviewsRepo: reviewsRepo}
+	return NewServiceWithEscalation(repo, reviewsRepo, EscalationConfig{})
+}
+
+// NewServiceWithEscalation creates a moderation service that escalates heavily reported items
+func NewServiceWithEscalation(repo Repository, reviewsRepo ReviewsRepository, escalation EscalationConfig) Service {
+	return &service{repo: repo, reviewsRepo: reviewsRepo, escalation: escalation}
 }
 
 // CreateToolReport creates a report for a tool
@@ -142,6 +183,11 @@ func (s *service) createReport(reportableType string, reportableID uint, userID
 		return nil, err
 	}
 
+	// Escalation is best effort; the report itself has been recorded
+	if err := s.escalateIfNeeded(report); err != nil {
+		log.Printf("Failed to escalate reports for %s %d: %v", reportableType, reportableID, err)
+	}
+
 	return report, nil
 }
 
@@ -181,7 +227,7 @@ func (s *service) ListReports(filters ReportFilters, page, pageSize int) ([]Repo
 		case "tool":
 			tool, err := s.repo.GetToolByID(report.ReportableID)
 			if err == nil {
-				result[i].Tool = tool
+				result[i].Tool = newModerationTool(tool)
 			}
 		case "review":
 			review, err := s.repo.GetReviewByID(report.ReportableID)
@@ -212,58 +258,246 @@ func (s *service) UpdateReportStatus(id uint, status string, reviewedBy uint) er
 	return s.repo.UpdateReportStatus(id, status, reviewedBy)
 }
 
-// ApproveReview approves a review for public display
+// ApproveReview approves a pending or rejected review for public display
 func (s *service) ApproveReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error) {
-	return s.moderateReview(reviewID, moderatorID, "approved", "approve", input)
+	return s.moderateReview(reviewID, moderatorID, "approve", input)
+}
+
+// RejectReview rejects a pending review so it is never displayed
+func (s *service) RejectReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error) {
+	return s.moderateReview(reviewID, moderatorID, "reject", input)
 }
 
-// HideReview hides a review from public display
+// HideReview hides an approved review from public display
 func (s *service) HideReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error) {
-	return s.moderateReview(reviewID, moderatorID, "hidden", "hide", input)
+	return s.moderateReview(reviewID, moderatorID, "hide", input)
 }
 
 // RemoveReview permanently hides a review and updates rating aggregates
 func (s *service) RemoveReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error) {
-	return s.moderateReview(reviewID, moderatorID, "removed", "remove", input)
+	return s.moderateReview(reviewID, moderatorID, "remove", input)
+}
+
+// RestoreReview returns a rejected, hidden or removed review to the status it had before
+func (s *service) RestoreReview(reviewID uint, moderatorID uint, input ModerationActionInput) (*domain.Review, error) {
+	return s.moderateReview(reviewID, moderatorID, "restore", input)
+}
+
+func (s *service) moderateReview(reviewID uint, moderatorID uint, actionType string, input ModerationActionInput) (*domain.Review, error) {
+	toolID, ratingsChanged, err := s.applyReviewAction(reviewID, &moderatorID, actionType, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if ratingsChanged {
+		s.updateRatingAggregates(toolID)
+	}
+
+	// Refresh review data
+	return s.repo.GetReviewByID(reviewID)
 }
 
-func (s *service) moderateReview(reviewID uint, moderatorID uint, status, actionType string, input ModerationActionInput) (*domain.Review, error) {
-	// Get review first
+// applyReviewAction moves a review through the moderation state machine. It returns the review's
+// tool and whether the transition changed which reviews count towards the tool's rating.
+// A nil moderator records the action as an automatic system action.
+func (s *service) applyReviewAction(reviewID uint, moderatorID *uint, actionType string, input ModerationActionInput) (uint, bool, error) {
 	review, err := s.repo.GetReviewByID(reviewID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrReviewNotFound
+			return 0, false, ErrReviewNotFound
 		}
-		return nil, err
+		return 0, false, err
 	}
 
-	// Update moderation status
-	err = s.repo.UpdateReviewModerationStatus(reviewID, status, moderatorID)
+	action, err := s.buildReviewAction(review, moderatorID, actionType, input)
 	if err != nil {
-		return nil, err
+		return 0, false, err
 	}
 
-	// Log moderation action
-	action := &domain.ModerationAction{
-		ReviewID:    reviewID,
+	// Update moderation status and log the action together
+	if err := s.repo.TransitionReviewStatus(action); err != nil {
+		if errors.Is(err, ErrInvalidTransition) {
+			return 0, false, fmt.Errorf("%w: review status changed concurrently", ErrInvalidTransition)
+		}
+		return 0, false, err
+	}
+
+	return review.ToolID, changesRatings(action), nil
+}
+
+// buildReviewAction checks that actionType is allowed on the review and returns the action that
+// moves it to its next status
+func (s *service) buildReviewAction(review *domain.Review, moderatorID *uint, actionType string, input ModerationActionInput) (*domain.ModerationAction, error) {
+	from := review.ModerationStatus
+	if !canApplyReviewAction(from, actionType) {
+		return nil, fmt.Errorf("%w: cannot %s a review that is %s", ErrInvalidTransition, actionType, from)
+	}
+
+	to := reviewActionTargets[actionType]
+	if actionType == "restore" {
+		var err error
+		to, err = s.previousReviewStatus(review.ID, from)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.ModerationAction{
+		ReviewID:    &review.ID,
 		ModeratorID: moderatorID,
 		ActionType:  actionType,
+		FromStatus:  from,
+		ToStatus:    to,
 		Notes:       input.Notes,
+	}, nil
+}
+
+// changesRatings reports whether a review transition changes which reviews count towards the
+// tool's rating. Only approved reviews count.
+func changesRatings(action *domain.ModerationAction) bool {
+	return action.FromStatus == "approved" || action.ToStatus == "approved"
+}
+
+// updateRatingAggregates recomputes a tool's rating aggregates after its public reviews changed
+func (s *service) updateRatingAggregates(toolID uint) {
+	if s.reviewsRepo != nil {
+		_ = s.reviewsRepo.UpdateToolRatingAggregates(toolID) // Log error but don't fail
 	}
-	_ = s.repo.CreateModerationAction(action) // Log error but don't fail
+}
 
-	// Update tool rating aggregates if review is hidden/removed or restored to approved
-	if s.reviewsRepo != nil && (status == "hidden" || status == "removed" || status == "approved") {
-		_ = s.reviewsRepo.UpdateToolRatingAggregates(review.ToolID) // Log error but don't fail
+// previousReviewStatus finds the status a review had before it moved into current
+func (s *service) previousReviewStatus(reviewID uint, current string) (string, error) {
+	action, err := s.repo.GetLastReviewTransition(reviewID, current)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return defaultRestoreTargets[current], nil
+		}
+		return "", err
 	}
+	if action.FromStatus == "" || action.FromStatus == current {
+		return defaultRestoreTargets[current], nil
+	}
+	return action.FromStatus, nil
+}
 
-	// Refresh review data
-	review, err = s.repo.GetReviewByID(reviewID)
+// UnflagTool clears the flag an escalation put on a tool once a moderator has looked at it
+func (s *service) UnflagTool(toolID uint, moderatorID uint, input ModerationActionInput) (*ModerationTool, error) {
+	if _, err := s.repo.GetToolByID(toolID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrToolNotFound
+		}
+		return nil, err
+	}
+
+	action := &domain.ModerationAction{
+		ToolID:      &toolID,
+		ModeratorID: &moderatorID,
+		ActionType:  "unflag",
+		Notes:       input.Notes,
+	}
+	if err := s.repo.UnflagTool(action); err != nil {
+		if errors.Is(err, ErrInvalidTransition) {
+			return nil, fmt.Errorf("%w: tool is not flagged", ErrInvalidTransition)
+		}
+		return nil, err
+	}
+
+	tool, err := s.repo.GetToolByID(toolID)
 	if err != nil {
 		return nil, err
 	}
+	return newModerationTool(tool), nil
+}
+
+// ListVendorResponses returns vendor responses in a moderation state with pagination
+func (s *service) ListVendorResponses(status string, page, pageSize int) ([]domain.VendorResponse, int64, error) {
+	if status != "" && !validModerationStatuses[status] {
+		return nil, 0, ErrInvalidModerationStatus
+	}
+	page, pageSize = s.validatePagination(page, pageSize)
+	return s.repo.ListVendorResponses(status, page, pageSize)
+}
+
+// ApproveVendorResponse publishes a pending or rejected vendor response below its review
+func (s *service) ApproveVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error) {
+	return s.moderateVendorResponse(responseID, moderatorID, "approve", input)
+}
+
+// RejectVendorResponse rejects a pending vendor response so it is never displayed
+func (s *service) RejectVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error) {
+	return s.moderateVendorResponse(responseID, moderatorID, "reject", input)
+}
+
+// HideVendorResponse hides an approved vendor response from public display
+func (s *service) HideVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error) {
+	return s.moderateVendorResponse(responseID, moderatorID, "hide", input)
+}
 
-	return review, nil
+// RemoveVendorResponse permanently hides a vendor response
+func (s *service) RemoveVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error) {
+	return s.moderateVendorResponse(responseID, moderatorID, "remove", input)
+}
+
+// RestoreVendorResponse returns a rejected, hidden or removed vendor response to the status it had before
+func (s *service) RestoreVendorResponse(responseID uint, moderatorID uint, input ModerationActionInput) (*domain.VendorResponse, error) {
+	return s.moderateVendorResponse(responseID, moderatorID, "restore", input)
+}
+
+func (s *service) moderateVendorResponse(responseID uint, moderatorID uint, actionType string, input ModerationActionInput) (*domain.VendorResponse, error) {
+	response, err := s.repo.GetVendorResponseByID(responseID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResponseNotFound
+		}
+		return nil, err
+	}
+
+	from := response.ModerationStatus
+	if !canApplyVendorResponseAction(from, actionType) {
+		return nil, fmt.Errorf("%w: cannot %s a vendor response that is %s", ErrInvalidTransition, actionType, from)
+	}
+
+	to := reviewActionTargets[actionType]
+	if actionType == "restore" {
+		to, err = s.previousVendorResponseStatus(responseID, from)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Update moderation status and log the action against the review the response belongs to
+	action := &domain.ModerationAction{
+		ReviewID:         &response.ReviewID,
+		VendorResponseID: &response.ID,
+		ModeratorID:      &moderatorID,
+		ActionType:       actionType,
+		FromStatus:       from,
+		ToStatus:         to,
+		Notes:            input.Notes,
+	}
+	if err := s.repo.TransitionVendorResponseStatus(action); err != nil {
+		if errors.Is(err, ErrInvalidTransition) {
+			return nil, fmt.Errorf("%w: vendor response status changed concurrently", ErrInvalidTransition)
+		}
+		return nil, err
+	}
+
+	return s.repo.GetVendorResponseByID(responseID)
+}
+
+// previousVendorResponseStatus finds the status a vendor response had before it moved into current
+func (s *service) previousVendorResponseStatus(responseID uint, current string) (string, error) {
+	action, err := s.repo.GetLastVendorResponseTransition(responseID, current)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return defaultRestoreTargets[current], nil
+		}
+		return "", err
+	}
+	if action.FromStatus == "" || action.FromStatus == current {
+		return defaultRestoreTargets[current], nil
+	}
+	return action.FromStatus, nil
 }
 
 // GetModerationHistory returns audit log of moderation actions for a review
@@ -286,12 +520,19 @@ func (s *service) GetModerationHistory(reviewID uint) ([]ModerationActionRespons
 	result := make([]ModerationActionResponse, len(actions))
 	for i, action := range actions {
 		result[i] = ModerationActionResponse{
-			ID:         action.ID,
-			ActionType: action.ActionType,
-			Notes:      action.Notes,
-			CreatedAt:  action.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			ID:               action.ID,
+			ActionType:       action.ActionType,
+			FromStatus:       action.FromStatus,
+			ToStatus:         action.ToStatus,
+			VendorResponseID: action.VendorResponseID,
+			Notes:            action.Notes,
+			CreatedAt:        action.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+		if action.ModeratorID == nil {
+			result[i].System = true
+			continue
 		}
-		result[i].Moderator.ID = action.ModeratorID
+		result[i].Moderator.ID = *action.ModeratorID
 		if action.Moderator.DisplayName != "" {
 			result[i].Moderator.DisplayName = action.Moderator.DisplayName
 		}
@@ -0,0 +1,642 @@
+package moderation_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/moderation"
+	"gorm.io/gorm"
+)
+
+// MockRepository is a mock implementation of moderation.Repository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CreateReport(report *moderation.Report) error {
+	args := m.Called(report)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetReportByID(id uint) (*moderation.Report, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*moderation.Report), args.Error(1)
+}
+
+func (m *MockRepository) ListPendingReports(page, pageSize int) ([]moderation.Report, int64, error) {
+	args := m.Called(page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]moderation.Report), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRepository) ListReports(filters moderation.ReportFilters, page, pageSize int) ([]moderation.Report, int64, error) {
+	args := m.Called(filters, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]moderation.Report), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRepository) UpdateReportStatus(id uint, status string, reviewedBy uint) error {
+	args := m.Called(id, status, reviewedBy)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ResolveReviewReport(reportID uint, reviewedBy uint, action *domain.ModerationAction) error {
+	args := m.Called(reportID, reviewedBy, action)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CountUserReportsToday(userID uint, reportableType string, reportableID uint) (int64, error) {
+	args := m.Called(userID, reportableType, reportableID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) CountAnonReportsToday(reportableType string, reportableID uint) (int64, error) {
+	args := m.Called(reportableType, reportableID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) CountUnescalatedReporters(reportableType string, reportableID uint, reason string, since time.Time) (int64, error) {
+	args := m.Called(reportableType, reportableID, reason, since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) EscalateReports(reportableType string, reportableID uint, action *domain.ModerationAction) error {
+	args := m.Called(reportableType, reportableID, action)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetReviewByID(id uint) (*domain.Review, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockRepository) TransitionReviewStatus(action *domain.ModerationAction) error {
+	args := m.Called(action)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetVendorResponseByID(id uint) (*domain.VendorResponse, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VendorResponse), args.Error(1)
+}
+
+func (m *MockRepository) ListVendorResponses(status string, page, pageSize int) ([]domain.VendorResponse, int64, error) {
+	args := m.Called(status, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]domain.VendorResponse), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRepository) TransitionVendorResponseStatus(action *domain.ModerationAction) error {
+	args := m.Called(action)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetLastVendorResponseTransition(responseID uint, toStatus string) (*domain.ModerationAction, error) {
+	args := m.Called(responseID, toStatus)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ModerationAction), args.Error(1)
+}
+
+func (m *MockRepository) GetModerationHistory(reviewID uint) ([]domain.ModerationAction, error) {
+	args := m.Called(reviewID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ModerationAction), args.Error(1)
+}
+
+func (m *MockRepository) GetLastReviewTransition(reviewID uint, toStatus string) (*domain.ModerationAction, error) {
+	args := m.Called(reviewID, toStatus)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ModerationAction), args.Error(1)
+}
+
+func (m *MockRepository) GetToolByID(id uint) (*domain.Tool, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Tool), args.Error(1)
+}
+
+func (m *MockRepository) UnflagTool(action *domain.ModerationAction) error {
+	args := m.Called(action)
+	return args.Error(0)
+}
+
+// MockReviewsRepository is a mock implementation of moderation.ReviewsRepository
+type MockReviewsRepository struct {
+	mock.Mock
+}
+
+func (m *MockReviewsRepository) UpdateToolRatingAggregates(toolID uint) error {
+	args := m.Called(toolID)
+	return args.Error(0)
+}
+
+func reviewWithStatus(id, toolID uint, status string) *domain.Review {
+	return &domain.Review{ID: id, ToolID: toolID, ModerationStatus: status}
+}
+
+// transition matches a moderation action moving an item from one status to another
+func transition(actionType, from, to string) interface{} {
+	return mock.MatchedBy(func(a *domain.ModerationAction) bool {
+		return a != nil && a.ActionType == actionType && a.FromStatus == from && a.ToStatus == to
+	})
+}
+
+// moderateReview calls the service method for a review action
+func moderateReview(s moderation.Service, action string, reviewID uint) (*domain.Review, error) {
+	input := moderation.ModerationActionInput{Notes: "checked"}
+	switch action {
+	case "approve":
+		return s.ApproveReview(reviewID, 1, input)
+	case "reject":
+		return s.RejectReview(reviewID, 1, input)
+	case "hide":
+		return s.HideReview(reviewID, 1, input)
+	case "remove":
+		return s.RemoveReview(reviewID, 1, input)
+	default:
+		return s.RestoreReview(reviewID, 1, input)
+	}
+}
+
+func TestServiceReviewTransitions(t *testing.T) {
+	// Where each action takes a review, or "" if the action is not allowed from that status.
+	// restore uses the fallback target since these reviews have no recorded history.
+	expected := map[string]map[string]string{
+		"pending":  {"approve": "approved", "reject": "rejected", "hide": "", "remove": "removed", "restore": ""},
+		"approved": {"approve": "", "reject": "", "hide": "hidden", "remove": "removed", "restore": ""},
+		"rejected": {"approve": "approved", "reject": "", "hide": "", "remove": "removed", "restore": "pending"},
+		"hidden":   {"approve": "", "reject": "", "hide": "", "remove": "removed", "restore": "approved"},
+		"removed":  {"approve": "", "reject": "", "hide": "", "remove": "", "restore": "approved"},
+		"deleted":  {"approve": "", "reject": "", "hide": "", "remove": "", "restore": ""},
+	}
+
+	for from, actions := range expected {
+		for action, to := range actions {
+			t.Run(action+" from "+from, func(t *testing.T) {
+				mockRepo := new(MockRepository)
+				mockReviews := new(MockReviewsRepository)
+				mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, from), nil)
+				mockRepo.On("GetLastReviewTransition", uint(10), from).Return(nil, gorm.ErrRecordNotFound).Maybe()
+				mockRepo.On("TransitionReviewStatus", mock.Anything).Return(nil).Maybe()
+				mockReviews.On("UpdateToolRatingAggregates", uint(3)).Return(nil).Maybe()
+
+				service := moderation.NewService(mockRepo, mockReviews)
+				_, err := moderateReview(service, action, 10)
+
+				if to == "" {
+					assert.ErrorIs(t, err, moderation.ErrInvalidTransition)
+					mockRepo.AssertNotCalled(t, "TransitionReviewStatus", mock.Anything)
+					return
+				}
+				assert.NoError(t, err)
+				mockRepo.AssertCalled(t, "TransitionReviewStatus", transition(action, from, to))
+				if from == "approved" || to == "approved" {
+					mockReviews.AssertCalled(t, "UpdateToolRatingAggregates", uint(3))
+				} else {
+					mockReviews.AssertNotCalled(t, "UpdateToolRatingAggregates", mock.Anything)
+				}
+			})
+		}
+	}
+}
+
+func TestServiceRestoreReview(t *testing.T) {
+	t.Run("returns review to the status recorded before it was removed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockReviews := new(MockReviewsRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "removed"), nil)
+		mockRepo.On("GetLastReviewTransition", uint(10), "removed").
+			Return(&domain.ModerationAction{ActionType: "remove", FromStatus: "hidden", ToStatus: "removed"}, nil)
+		mockRepo.On("TransitionReviewStatus", transition("restore", "removed", "hidden")).Return(nil)
+
+		service := moderation.NewService(mockRepo, mockReviews)
+		_, err := service.RestoreReview(10, 1, moderation.ModerationActionInput{})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockReviews.AssertNotCalled(t, "UpdateToolRatingAggregates", mock.Anything)
+	})
+
+	t.Run("falls back to the default target when history has no previous status", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockReviews := new(MockReviewsRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "hidden"), nil)
+		mockRepo.On("GetLastReviewTransition", uint(10), "hidden").
+			Return(&domain.ModerationAction{ActionType: "hide", ToStatus: "hidden"}, nil)
+		mockRepo.On("TransitionReviewStatus", transition("restore", "hidden", "approved")).Return(nil)
+		mockReviews.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := moderation.NewService(mockRepo, mockReviews)
+		_, err := service.RestoreReview(10, 1, moderation.ModerationActionInput{})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockReviews.AssertExpectations(t)
+	})
+
+	t.Run("returns history lookup errors", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "hidden"), nil)
+		mockRepo.On("GetLastReviewTransition", uint(10), "hidden").Return(nil, errors.New("db down"))
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.RestoreReview(10, 1, moderation.ModerationActionInput{})
+
+		assert.EqualError(t, err, "db down")
+		mockRepo.AssertNotCalled(t, "TransitionReviewStatus", mock.Anything)
+	})
+}
+
+func TestServiceModerateReviewErrors(t *testing.T) {
+	t.Run("returns ErrReviewNotFound for missing review", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.HideReview(99, 1, moderation.ModerationActionInput{})
+
+		assert.ErrorIs(t, err, moderation.ErrReviewNotFound)
+	})
+
+	t.Run("reports a concurrent status change as an invalid transition", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockReviews := new(MockReviewsRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "approved"), nil)
+		mockRepo.On("TransitionReviewStatus", mock.Anything).Return(moderation.ErrInvalidTransition)
+
+		service := moderation.NewService(mockRepo, mockReviews)
+		_, err := service.HideReview(10, 1, moderation.ModerationActionInput{})
+
+		assert.ErrorIs(t, err, moderation.ErrInvalidTransition)
+		assert.Contains(t, err.Error(), "changed concurrently")
+		mockReviews.AssertNotCalled(t, "UpdateToolRatingAggregates", mock.Anything)
+	})
+}
+
+func TestServiceVendorResponseTransitions(t *testing.T) {
+	response := func(status string) *domain.VendorResponse {
+		return &domain.VendorResponse{ID: 5, ReviewID: 10, ModerationStatus: status}
+	}
+
+	t.Run("rejects a pending response", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetVendorResponseByID", uint(5)).Return(response("pending"), nil)
+		mockRepo.On("TransitionVendorResponseStatus", mock.MatchedBy(func(a *domain.ModerationAction) bool {
+			return a.ActionType == "reject" && a.FromStatus == "pending" && a.ToStatus == "rejected" &&
+				*a.VendorResponseID == 5 && *a.ReviewID == 10
+		})).Return(nil)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.RejectVendorResponse(5, 1, moderation.ModerationActionInput{})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("refuses actions the state machine does not allow", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetVendorResponseByID", uint(5)).Return(response("pending"), nil)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.HideVendorResponse(5, 1, moderation.ModerationActionInput{})
+
+		assert.ErrorIs(t, err, moderation.ErrInvalidTransition)
+		mockRepo.AssertNotCalled(t, "TransitionVendorResponseStatus", mock.Anything)
+	})
+
+	t.Run("restores a response to the status recorded in its history", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetVendorResponseByID", uint(5)).Return(response("removed"), nil)
+		mockRepo.On("GetLastVendorResponseTransition", uint(5), "removed").
+			Return(&domain.ModerationAction{ActionType: "remove", FromStatus: "pending", ToStatus: "removed"}, nil)
+		mockRepo.On("TransitionVendorResponseStatus", transition("restore", "removed", "pending")).Return(nil)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.RestoreVendorResponse(5, 1, moderation.ModerationActionInput{})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("restores a hidden response to approved without history", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetVendorResponseByID", uint(5)).Return(response("hidden"), nil)
+		mockRepo.On("GetLastVendorResponseTransition", uint(5), "hidden").Return(nil, gorm.ErrRecordNotFound)
+		mockRepo.On("TransitionVendorResponseStatus", transition("restore", "hidden", "approved")).Return(nil)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.RestoreVendorResponse(5, 1, moderation.ModerationActionInput{})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns ErrResponseNotFound for missing response", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetVendorResponseByID", uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.ApproveVendorResponse(99, 1, moderation.ModerationActionInput{})
+
+		assert.ErrorIs(t, err, moderation.ErrResponseNotFound)
+	})
+}
+
+func TestServiceBulkModerate(t *testing.T) {
+	t.Run("rejects more than MaxBulkItems ids", func(t *testing.T) {
+		ids := make([]uint, moderation.MaxBulkItems+1)
+		for i := range ids {
+			ids[i] = uint(i + 1)
+		}
+		mockRepo := new(MockRepository)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.BulkModerate(moderation.BulkModerationInput{TargetType: "review", Action: "hide", IDs: ids}, 1)
+
+		assert.ErrorIs(t, err, moderation.ErrTooManyBulkItems)
+		mockRepo.AssertNotCalled(t, "GetReviewByID", mock.Anything)
+	})
+
+	t.Run("accepts MaxBulkItems ids once duplicates are dropped", func(t *testing.T) {
+		ids := make([]uint, 0, moderation.MaxBulkItems+1)
+		for i := 1; i <= moderation.MaxBulkItems; i++ {
+			ids = append(ids, uint(i))
+		}
+		ids = append(ids, 1)
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+		service := moderation.NewService(mockRepo, nil)
+		result, err := service.BulkModerate(moderation.BulkModerationInput{TargetType: "review", Action: "hide", IDs: ids}, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Results, moderation.MaxBulkItems)
+	})
+
+	t.Run("validates target type, action and ids", func(t *testing.T) {
+		service := moderation.NewService(new(MockRepository), nil)
+
+		_, err := service.BulkModerate(moderation.BulkModerationInput{TargetType: "tool", Action: "hide", IDs: []uint{1}}, 1)
+		assert.ErrorIs(t, err, moderation.ErrInvalidBulkTarget)
+
+		_, err = service.BulkModerate(moderation.BulkModerationInput{TargetType: "review", Action: "dismiss", IDs: []uint{1}}, 1)
+		assert.ErrorIs(t, err, moderation.ErrInvalidBulkAction)
+
+		_, err = service.BulkModerate(moderation.BulkModerationInput{TargetType: "review", Action: "hide"}, 1)
+		assert.ErrorIs(t, err, moderation.ErrNoBulkItems)
+	})
+
+	t.Run("reports each item and recomputes each tool once", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockReviews := new(MockReviewsRepository)
+		mockRepo.On("GetReviewByID", uint(1)).Return(reviewWithStatus(1, 3, "approved"), nil)
+		mockRepo.On("GetReviewByID", uint(2)).Return(reviewWithStatus(2, 3, "approved"), nil)
+		mockRepo.On("GetReviewByID", uint(3)).Return(reviewWithStatus(3, 4, "approved"), nil)
+		mockRepo.On("GetReviewByID", uint(4)).Return(reviewWithStatus(4, 4, "pending"), nil)
+		mockRepo.On("GetReviewByID", uint(5)).Return(nil, gorm.ErrRecordNotFound)
+		mockRepo.On("TransitionReviewStatus", transition("hide", "approved", "hidden")).Return(nil)
+		mockReviews.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+		mockReviews.On("UpdateToolRatingAggregates", uint(4)).Return(nil)
+
+		service := moderation.NewService(mockRepo, mockReviews)
+		result, err := service.BulkModerate(moderation.BulkModerationInput{
+			TargetType: "review",
+			Action:     "hide",
+			IDs:        []uint{1, 2, 3, 4, 5},
+		}, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.Succeeded)
+		assert.Equal(t, 2, result.Failed)
+		assert.Equal(t, []moderation.BulkItemResult{
+			{ID: 1, Success: true},
+			{ID: 2, Success: true},
+			{ID: 3, Success: true},
+			{ID: 4, Code: "INVALID_TRANSITION", Error: "moderation action is not allowed in the current status: cannot hide a review that is pending"},
+			{ID: 5, Code: "NOT_FOUND", Error: "review not found"},
+		}, result.Results)
+		mockReviews.AssertNumberOfCalls(t, "UpdateToolRatingAggregates", 2)
+		mockReviews.AssertExpectations(t)
+	})
+
+	t.Run("resolves reports together with the review they point at", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockReviews := new(MockReviewsRepository)
+		report := func(id uint) *moderation.Report {
+			return &moderation.Report{ID: id, ReportableType: "review", ReportableID: 10, Status: "pending"}
+		}
+		mockRepo.On("GetReportByID", uint(1)).Return(report(1), nil)
+		mockRepo.On("GetReportByID", uint(2)).Return(report(2), nil)
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "approved"), nil).Once()
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "hidden"), nil).Once()
+		mockRepo.On("ResolveReviewReport", uint(1), uint(7), transition("hide", "approved", "hidden")).Return(nil)
+		mockRepo.On("ResolveReviewReport", uint(2), uint(7), (*domain.ModerationAction)(nil)).Return(nil)
+		mockReviews.On("UpdateToolRatingAggregates", uint(3)).Return(nil).Once()
+
+		service := moderation.NewService(mockRepo, mockReviews)
+		result, err := service.BulkModerate(moderation.BulkModerationInput{TargetType: "report", Action: "hide", IDs: []uint{1, 2}}, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Succeeded)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "TransitionReviewStatus", mock.Anything)
+		mockRepo.AssertNotCalled(t, "UpdateReportStatus", mock.Anything, mock.Anything, mock.Anything)
+		mockReviews.AssertExpectations(t)
+	})
+
+	t.Run("dismisses reports without touching the reported item", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReportByID", uint(1)).Return(&moderation.Report{ID: 1, ReportableType: "tool", ReportableID: 4, Status: "pending"}, nil)
+		mockRepo.On("GetReportByID", uint(2)).Return(&moderation.Report{ID: 2, ReportableType: "review", ReportableID: 10, Status: "dismissed"}, nil)
+		mockRepo.On("UpdateReportStatus", uint(1), "dismissed", uint(7)).Return(nil)
+
+		service := moderation.NewService(mockRepo, nil)
+		result, err := service.BulkModerate(moderation.BulkModerationInput{TargetType: "report", Action: "dismiss", IDs: []uint{1, 2}}, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Succeeded)
+		assert.Equal(t, "ALREADY_RESOLVED", result.Results[1].Code)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetReviewByID", mock.Anything)
+	})
+}
+
+func TestServiceEscalation(t *testing.T) {
+	escalation := moderation.EscalationConfig{
+		Thresholds: map[string]int{"spam": 3},
+		Window:     24 * time.Hour,
+	}
+	input := moderation.CreateReportInput{Reason: "spam"}
+	reporter := uint(7)
+
+	// reportRepo accepts a report from reporter
+	reportRepo := func() *MockRepository {
+		mockRepo := new(MockRepository)
+		mockRepo.On("CountUserReportsToday", reporter, mock.Anything, mock.Anything).Return(int64(0), nil)
+		mockRepo.On("CreateReport", mock.Anything).Return(nil)
+		return mockRepo
+	}
+
+	t.Run("does nothing below the threshold", func(t *testing.T) {
+		mockRepo := reportRepo()
+		mockRepo.On("CountUnescalatedReporters", "review", uint(10), "spam", mock.Anything).Return(int64(2), nil)
+
+		service := moderation.NewServiceWithEscalation(mockRepo, nil, escalation)
+		_, err := service.CreateReviewReport(10, &reporter, input)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "EscalateReports", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ignores reasons without a threshold", func(t *testing.T) {
+		mockRepo := reportRepo()
+
+		service := moderation.NewServiceWithEscalation(mockRepo, nil, escalation)
+		_, err := service.CreateReviewReport(10, &reporter, moderation.CreateReportInput{Reason: "other"})
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "CountUnescalatedReporters", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("hides an approved review at the threshold as a system action", func(t *testing.T) {
+		mockRepo := reportRepo()
+		mockReviews := new(MockReviewsRepository)
+		mockRepo.On("CountUnescalatedReporters", "review", uint(10), "spam", mock.Anything).Return(int64(3), nil)
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "approved"), nil)
+		mockRepo.On("EscalateReports", "review", uint(10), mock.MatchedBy(func(a *domain.ModerationAction) bool {
+			return a != nil && a.ActionType == "hide" && a.ToStatus == "hidden" && a.ModeratorID == nil
+		})).Return(nil)
+		mockReviews.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := moderation.NewServiceWithEscalation(mockRepo, mockReviews, escalation)
+		_, err := service.CreateReviewReport(10, &reporter, input)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockReviews.AssertExpectations(t)
+	})
+
+	t.Run("only escalates reports on reviews that are not public", func(t *testing.T) {
+		mockRepo := reportRepo()
+		mockRepo.On("CountUnescalatedReporters", "review", uint(10), "spam", mock.Anything).Return(int64(4), nil)
+		mockRepo.On("GetReviewByID", uint(10)).Return(reviewWithStatus(10, 3, "pending"), nil)
+		mockRepo.On("EscalateReports", "review", uint(10), (*domain.ModerationAction)(nil)).Return(nil)
+
+		service := moderation.NewServiceWithEscalation(mockRepo, nil, escalation)
+		_, err := service.CreateReviewReport(10, &reporter, input)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("flags a tool at the threshold", func(t *testing.T) {
+		mockRepo := reportRepo()
+		mockRepo.On("CountUnescalatedReporters", "tool", uint(4), "spam", mock.Anything).Return(int64(3), nil)
+		mockRepo.On("EscalateReports", "tool", uint(4), mock.MatchedBy(func(a *domain.ModerationAction) bool {
+			return a != nil && a.ActionType == "flag" && *a.ToolID == 4 && a.ModeratorID == nil
+		})).Return(nil)
+
+		service := moderation.NewServiceWithEscalation(mockRepo, nil, escalation)
+		_, err := service.CreateToolReport(4, &reporter, input)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("keeps the report when escalation fails", func(t *testing.T) {
+		mockRepo := reportRepo()
+		mockRepo.On("CountUnescalatedReporters", "tool", uint(4), "spam", mock.Anything).Return(int64(3), nil)
+		mockRepo.On("EscalateReports", "tool", uint(4), mock.Anything).Return(errors.New("db down"))
+
+		service := moderation.NewServiceWithEscalation(mockRepo, nil, escalation)
+		report, err := service.CreateToolReport(4, &reporter, input)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, report)
+	})
+
+	t.Run("never escalates on anonymous reports", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("CreateReport", mock.Anything).Return(nil)
+
+		service := moderation.NewServiceWithEscalation(mockRepo, nil, escalation)
+		for i := 0; i < 5; i++ {
+			_, err := service.CreateReviewReport(10, nil, input)
+			assert.NoError(t, err)
+		}
+
+		mockRepo.AssertNumberOfCalls(t, "CreateReport", 5)
+		mockRepo.AssertNotCalled(t, "CountUnescalatedReporters", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "EscalateReports", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "GetReviewByID", mock.Anything)
+	})
+}
+
+func TestServiceUnflagTool(t *testing.T) {
+	flaggedAt := time.Now()
+
+	t.Run("clears the flag and records the moderator", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByID", uint(4)).Return(&domain.Tool{ID: 4, FlaggedAt: &flaggedAt}, nil).Once()
+		mockRepo.On("GetToolByID", uint(4)).Return(&domain.Tool{ID: 4}, nil).Once()
+		mockRepo.On("UnflagTool", mock.MatchedBy(func(a *domain.ModerationAction) bool {
+			return a.ActionType == "unflag" && *a.ToolID == 4 && *a.ModeratorID == 1
+		})).Return(nil)
+
+		service := moderation.NewService(mockRepo, nil)
+		tool, err := service.UnflagTool(4, 1, moderation.ModerationActionInput{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, tool.FlaggedAt)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("refuses to unflag a tool that is not flagged", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByID", uint(4)).Return(&domain.Tool{ID: 4}, nil)
+		mockRepo.On("UnflagTool", mock.Anything).Return(moderation.ErrInvalidTransition)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.UnflagTool(4, 1, moderation.ModerationActionInput{})
+
+		assert.ErrorIs(t, err, moderation.ErrInvalidTransition)
+	})
+
+	t.Run("returns ErrToolNotFound for missing tool", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByID", uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		service := moderation.NewService(mockRepo, nil)
+		_, err := service.UnflagTool(99, 1, moderation.ModerationActionInput{})
+
+		assert.ErrorIs(t, err, moderation.ErrToolNotFound)
+		mockRepo.AssertNotCalled(t, "UnflagTool", mock.Anything)
+	})
+}
@@ -0,0 +1,47 @@
+package moderation
+
+// reviewActionTargets maps each moderation action to the status it moves a review to.
+// restore has no fixed target: it returns the review to the status it had before.
+var reviewActionTargets = map[string]string{
+	"approve": "approved",
+	"reject":  "rejected",
+	"hide":    "hidden",
+	"remove":  "removed",
+}
+
+// allowedReviewActions lists the moderation actions allowed from each review status
+var allowedReviewActions = map[string]map[string]bool{
+	"pending":  {"approve": true, "reject": true, "remove": true},
+	"approved": {"hide": true, "remove": true},
+	"rejected": {"approve": true, "restore": true, "remove": true},
+	"hidden":   {"restore": true, "remove": true},
+	"removed":  {"restore": true},
+}
+
+// defaultRestoreTargets is used when a review's history does not say what status it had
+// before its current one, e.g. for actions logged before transitions were recorded
+var defaultRestoreTargets = map[string]string{
+	"rejected": "pending",
+	"hidden":   "approved",
+	"removed":  "approved",
+}
+
+// canApplyReviewAction reports whether action is allowed on a review in status
+func canApplyReviewAction(status, action string) bool {
+	return allowedReviewActions[status][action]
+}
+
+// allowedVendorResponseActions lists the moderation actions allowed from each vendor response
+// status. Vendor responses move between the same statuses as reviews and use the same targets.
+var allowedVendorResponseActions = map[string]map[string]bool{
+	"pending":  {"approve": true, "reject": true, "remove": true},
+	"approved": {"hide": true, "remove": true},
+	"rejected": {"approve": true, "restore": true, "remove": true},
+	"hidden":   {"restore": true, "remove": true},
+	"removed":  {"restore": true},
+}
+
+// canApplyVendorResponseAction reports whether action is allowed on a vendor response in status
+func canApplyVendorResponseAction(status, action string) bool {
+	return allowedVendorResponseActions[status][action]
+}
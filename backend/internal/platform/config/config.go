@@ -2,7 +2,12 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Config holds application configuration
@@ -11,8 +16,69 @@ type Config struct {
 	JWTSecret      string
 	Port           string
 	AllowedOrigins string
+	// TrustedProxies lists the proxy IPs or CIDRs whose X-Forwarded-For header is believed
+	// when working out the client IP; empty trusts no proxy and uses the connection address
+	TrustedProxies []string
+	Trending       TrendingConfig
+	Moderation     ReviewModerationConfig
+	Escalation     ReportEscalationConfig
+	Mail           MailConfig
+	// RatingConfidenceWeight is the number of catalog-average votes blended
+	// into each tool's Bayesian rating
+	RatingConfidenceWeight float64
+	// RatingRefreshInterval is how often the catalog mean behind Bayesian ratings is refreshed
+	RatingRefreshInterval time.Duration
 }
 
+// TrendingConfig controls the trending score job
+type TrendingConfig struct {
+	Interval       time.Duration // How often scores are recomputed
+	HalfLife       time.Duration // Age at which an event counts half as much
+	Window         time.Duration // Events older than this are ignored
+	WeightBookmark float64
+	WeightReview   float64
+	WeightView     float64
+	WeightClickOut float64
+}
+
+// ReviewModerationConfig controls pre-moderation of new reviews
+type ReviewModerationConfig struct {
+	Mode                  string   // "approve", "hold" or "rules"
+	Rules                 []string // Rules that hold a review in "rules" mode
+	ProfanityList         []string // Words that trigger the profanity rule
+	ExtremeRatingMinChars int      // 1 or 5 star reviews with less pros and cons text than this are held
+}
+
+// ReportEscalationConfig controls automatic escalation of reviews and tools that pile up reports
+type ReportEscalationConfig struct {
+	Thresholds map[string]int // Signed-in reporters per reason within Window that trigger escalation; missing or 0 disables
+	Window     time.Duration  // Only reports newer than this count towards a threshold
+}
+
+// MailConfig controls delivery of account emails such as password resets
+type MailConfig struct {
+	Driver       string // "log" logs recipients and subjects and writes emails to LogDir if set, "smtp" sends them
+	From         string
+	AppURL       string // Frontend base URL that links in emails point to
+	LogDir       string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+}
+
+// Review moderation modes and rules accepted in configuration
+var (
+	moderationModes = map[string]bool{"approve": true, "hold": true, "rules": true}
+	moderationRules = map[string]bool{
+		"first_time_reviewer": true,
+		"contains_links":      true,
+		"profanity":           true,
+		"extreme_rating":      true,
+	}
+	reportReasons = map[string]bool{"spam": true, "abuse": true, "misinformation": true, "other": true}
+)
+
 // Load reads configuration from environment variables
 func Load() (*Config, error) {
 	databaseURL := os.Getenv("DATABASE_URL")
@@ -35,10 +101,244 @@ func Load() (*Config, error) {
 		allowedOrigins = "http://localhost:3000" // Default origin
 	}
 
+	trustedProxies, err := loadTrustedProxies()
+	if err != nil {
+		return nil, err
+	}
+
+	trending, err := loadTrending()
+	if err != nil {
+		return nil, err
+	}
+
+	moderation, err := loadModeration()
+	if err != nil {
+		return nil, err
+	}
+
+	escalation, err := loadEscalation()
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := loadMail()
+	if err != nil {
+		return nil, err
+	}
+
+	ratingWeight, err := floatEnv("RATING_CONFIDENCE_WEIGHT", 10)
+	if err != nil {
+		return nil, err
+	}
+
+	ratingRefreshInterval, err := durationEnv("RATING_REFRESH_INTERVAL", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Config{
-		DatabaseURL:    databaseURL,
-		JWTSecret:      jwtSecret,
-		Port:           port,
-		AllowedOrigins: allowedOrigins,
+		DatabaseURL:            databaseURL,
+		JWTSecret:              jwtSecret,
+		Port:                   port,
+		AllowedOrigins:         allowedOrigins,
+		TrustedProxies:         trustedProxies,
+		Trending:               trending,
+		Moderation:             moderation,
+		Escalation:             escalation,
+		Mail:                   mail,
+		RatingConfidenceWeight: ratingWeight,
+		RatingRefreshInterval:  ratingRefreshInterval,
 	}, nil
 }
+
+// loadTrending reads the trending job settings, falling back to defaults
+func loadTrending() (TrendingConfig, error) {
+	var cfg TrendingConfig
+	var err error
+
+	if cfg.Interval, err = durationEnv("TRENDING_INTERVAL", time.Hour); err != nil {
+		return cfg, err
+	}
+	if cfg.HalfLife, err = durationEnv("TRENDING_HALF_LIFE", 72*time.Hour); err != nil {
+		return cfg, err
+	}
+	if cfg.Window, err = durationEnv("TRENDING_WINDOW", 30*24*time.Hour); err != nil {
+		return cfg, err
+	}
+	if cfg.WeightBookmark, err = floatEnv("TRENDING_WEIGHT_BOOKMARK", 3); err != nil {
+		return cfg, err
+	}
+	if cfg.WeightReview, err = floatEnv("TRENDING_WEIGHT_REVIEW", 5); err != nil {
+		return cfg, err
+	}
+	if cfg.WeightView, err = floatEnv("TRENDING_WEIGHT_VIEW", 0.1); err != nil {
+		return cfg, err
+	}
+	if cfg.WeightClickOut, err = floatEnv("TRENDING_WEIGHT_CLICK_OUT", 1); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// loadModeration reads the review pre-moderation settings; by default every review is approved
+func loadModeration() (ReviewModerationConfig, error) {
+	cfg := ReviewModerationConfig{
+		Mode:          os.Getenv("REVIEW_MODERATION_MODE"),
+		Rules:         listEnv("REVIEW_MODERATION_RULES", []string{"first_time_reviewer", "contains_links", "profanity", "extreme_rating"}),
+		ProfanityList: listEnv("REVIEW_PROFANITY_LIST", nil),
+	}
+	if cfg.Mode == "" {
+		cfg.Mode = "approve"
+	}
+	if !moderationModes[cfg.Mode] {
+		return cfg, errors.New("REVIEW_MODERATION_MODE must be one of: approve, hold, rules")
+	}
+	for _, rule := range cfg.Rules {
+		if !moderationRules[rule] {
+			return cfg, fmt.Errorf("REVIEW_MODERATION_RULES contains unknown rule %q", rule)
+		}
+	}
+
+	var err error
+	if cfg.ExtremeRatingMinChars, err = intEnv("REVIEW_EXTREME_RATING_MIN_CHARS", 40); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// loadEscalation reads report escalation thresholds given as reason:count pairs,
+// e.g. "spam:3,abuse:3,misinformation:5"
+func loadEscalation() (ReportEscalationConfig, error) {
+	cfg := ReportEscalationConfig{Thresholds: make(map[string]int)}
+
+	for _, pair := range listEnv("REPORT_ESCALATION_THRESHOLDS", []string{"spam:3", "abuse:3", "misinformation:5"}) {
+		reason, count, found := strings.Cut(pair, ":")
+		reason = strings.TrimSpace(reason)
+		if !found || !reportReasons[reason] {
+			return cfg, fmt.Errorf("REPORT_ESCALATION_THRESHOLDS contains invalid entry %q", pair)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || n < 0 {
+			return cfg, fmt.Errorf("REPORT_ESCALATION_THRESHOLDS count for %q must be a non-negative integer", reason)
+		}
+		cfg.Thresholds[reason] = n
+	}
+
+	var err error
+	if cfg.Window, err = durationEnv("REPORT_ESCALATION_WINDOW", 24*time.Hour); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// loadTrustedProxies reads the proxies allowed to set X-Forwarded-For; by default none are trusted
+func loadTrustedProxies() ([]string, error) {
+	proxies := listEnv("TRUSTED_PROXIES", nil)
+	for _, proxy := range proxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return nil, fmt.Errorf("TRUSTED_PROXIES contains invalid IP or CIDR %q", proxy)
+			}
+		}
+	}
+	return proxies, nil
+}
+
+// loadMail reads the mail settings; by default emails are only logged.
+// Logged emails are never delivered, so the log driver is refused outside development.
+func loadMail() (MailConfig, error) {
+	cfg := MailConfig{
+		Driver:       stringEnv("MAIL_DRIVER", "log"),
+		From:         stringEnv("MAIL_FROM", "AI Tools Atlas <no-reply@localhost>"),
+		AppURL:       strings.TrimRight(stringEnv("APP_URL", "http://localhost:5173"), "/"),
+		LogDir:       os.Getenv("MAIL_LOG_DIR"),
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+	}
+
+	switch cfg.Driver {
+	case "log":
+		if env := stringEnv("APP_ENV", "development"); env != "development" {
+			return cfg, fmt.Errorf("MAIL_DRIVER log is only allowed in development, not APP_ENV %s", env)
+		}
+	case "smtp":
+		if cfg.SMTPHost == "" {
+			return cfg, errors.New("SMTP_HOST is required when MAIL_DRIVER is smtp")
+		}
+	default:
+		return cfg, errors.New("MAIL_DRIVER must be one of: log, smtp")
+	}
+
+	var err error
+	if cfg.SMTPPort, err = intEnv("SMTP_PORT", 587); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// stringEnv reads a string from the environment, falling back if it is empty
+func stringEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// durationEnv parses a positive duration such as "72h" from the environment
+func durationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration", key)
+	}
+	return d, nil
+}
+
+// floatEnv parses a non-negative number from the environment
+func floatEnv(key string, fallback float64) (float64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative number", key)
+	}
+	return f, nil
+}
+
+// intEnv parses a non-negative integer from the environment
+func intEnv(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer", key)
+	}
+	return n, nil
+}
+
+// listEnv splits a comma-separated environment variable, dropping empty entries
+func listEnv(key string, fallback []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	var list []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, part)
+		}
+	}
+	return list
+}
@@ -55,7 +55,9 @@ func TestMigrations(t *testing.T) {
 		tables := []string{
 			"categories", "tools", "tags", "tool_tags",
 			"media", "badges", "tool_badges", "tool_alternatives",
-			"users", "reviews", "bookmarks",
+			"users", "reviews", "bookmarks", "tool_events",
+			"review_votes", "review_edits", "tool_owners", "vendor_responses",
+			"sessions", "account_tokens", "login_attempts", "rating_prior",
 		}
 
 		for _, table := range tables {
@@ -87,6 +89,22 @@ func TestMigrations(t *testing.T) {
 			"idx_reviews_tool_id",
 			"idx_reviews_user_id",
 			"idx_bookmarks_user_tool",
+			"idx_tools_search_vector",
+			"idx_tools_name_trgm",
+			"idx_categories_name_trgm",
+			"idx_tags_name_trgm",
+			"idx_tools_keyset_top_rated",
+			"idx_tools_keyset_newest",
+			"idx_tools_keyset_category",
+			"idx_reviews_keyset_newest",
+			"idx_reviews_keyset_most_helpful",
+			"idx_tool_events_tool_type_created",
+			"idx_tool_events_created_at",
+			"idx_review_votes_review_user",
+			"idx_review_votes_review_session",
+			"idx_review_edits_review_id",
+			"idx_tool_owners_tool_user",
+			"idx_vendor_responses_review_id",
 		}
 
 		for _, index := range indexes {
@@ -205,6 +223,15 @@ func TestMigrations(t *testing.T) {
 			t.Error("Expected CHECK constraint violation for reviews.moderation_status, but insert succeeded")
 		}
 
+		// Hidden and removed are valid review moderation statuses
+		_, err = db.Exec(`
+			INSERT INTO reviews (tool_id, user_id, rating_overall, moderation_status)
+			VALUES ($1, $2, 5, 'hidden')
+		`, toolID2, userID)
+		if err != nil {
+			t.Errorf("Expected hidden to be a valid reviews.moderation_status, got: %v", err)
+		}
+
 		// Cleanup
 		db.Exec(`DELETE FROM reviews WHERE user_id = $1`, userID)
 		db.Exec(`DELETE FROM tools WHERE slug = 'test-tool-2'`)
@@ -383,6 +410,7 @@ func TestSchemaDetails(t *testing.T) {
 			"primary_use_cases", "pricing_summary", "target_roles", "platforms",
 			"has_free_tier", "official_url", "primary_category_id", "avg_rating_overall",
 			"review_count", "bookmark_count", "trending_score", "created_at", "updated_at", "archived_at",
+			"bayesian_rating", "flagged_at",
 		})
 	})
 
@@ -425,6 +453,27 @@ func TestSchemaDetails(t *testing.T) {
 	t.Run("users table has correct columns", func(t *testing.T) {
 		verifyColumns(t, "users", []string{
 			"id", "email", "password_hash", "display_name", "role", "created_at", "updated_at",
+			"suspended_at", "suspended_by", "suspension_reason", "last_login_at",
+			"email_verified_at",
+		})
+	})
+
+	t.Run("login_attempts table has correct columns", func(t *testing.T) {
+		verifyColumns(t, "login_attempts", []string{
+			"id", "email", "user_id", "ip_address", "user_agent", "success", "failure_reason", "created_at",
+		})
+	})
+
+	t.Run("account_tokens table has correct columns", func(t *testing.T) {
+		verifyColumns(t, "account_tokens", []string{
+			"id", "user_id", "purpose", "token_hash", "expires_at", "used_at", "created_at",
+		})
+	})
+
+	t.Run("sessions table has correct columns", func(t *testing.T) {
+		verifyColumns(t, "sessions", []string{
+			"id", "user_id", "refresh_token_hash", "previous_token_hash", "user_agent",
+			"ip_address", "expires_at", "last_used_at", "revoked_at", "created_at",
 		})
 	})
 
@@ -438,6 +487,13 @@ func TestSchemaDetails(t *testing.T) {
 		})
 	})
 
+	t.Run("moderation_actions table has correct columns", func(t *testing.T) {
+		verifyColumns(t, "moderation_actions", []string{
+			"id", "review_id", "tool_id", "moderator_id", "action_type", "from_status", "to_status",
+			"vendor_response_id", "notes", "created_at",
+		})
+	})
+
 	t.Run("bookmarks table has correct columns", func(t *testing.T) {
 		verifyColumns(t, "bookmarks", []string{
 			"id", "user_id", "tool_id", "created_at",
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"log"
 	"strings"
 	"time"
@@ -39,6 +40,7 @@ func CORSMiddleware(allowedOrigins string) gin.HandlerFunc {
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE, PATCH")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "Retry-After")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -84,30 +86,28 @@ func AuthRequired(authService *auth.Service) gin.HandlerFunc {
 			return
 		}
 
-		// Set user context
-		c.Set("user_id", claims.UserID)
-		c.Set("user_role", claims.Role)
-		c.Set("user_email", claims.Email)
-
-		c.Next()
-	}
-}
-
-// AdminRequired middleware checks if user has admin role
-func AdminRequired() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		role, exists := c.Get("user_role")
-		if !exists {
-			ErrorResponse(c, 403, "forbidden", "Access denied: role not found", nil)
+		// Reject suspended or deleted users and revoked sessions even if the token has not expired
+		role, err := authService.AuthenticateClaims(claims)
+		if err != nil {
+			switch {
+			case errors.Is(err, auth.ErrUserSuspended):
+				ErrorResponse(c, 403, "account_suspended", "Account is suspended", nil)
+			case errors.Is(err, auth.ErrUserNotFound):
+				ErrorResponse(c, 401, "unauthorized", "Invalid token", nil)
+			case errors.Is(err, auth.ErrSessionRevoked):
+				ErrorResponse(c, 401, "session_revoked", "Session has been revoked", nil)
+			default:
+				ErrorResponse(c, 500, "internal_error", "Failed to authenticate", nil)
+			}
 			c.Abort()
 			return
 		}
 
-		if role != "admin" {
-			ErrorResponse(c, 403, "forbidden", "Access denied: admin role required", nil)
-			c.Abort()
-			return
-		}
+		// Set user context, using the current role rather than the one in the token
+		c.Set("user_id", claims.UserID)
+		c.Set("user_role", role)
+		c.Set("user_email", claims.Email)
+		c.Set("auth_session_id", claims.SessionID)
 
 		c.Next()
 	}
@@ -132,10 +132,18 @@ func OptionalAuth(authService *auth.Service) gin.HandlerFunc {
 			return
 		}
 
+		// Suspended or deleted users and revoked sessions continue without authentication
+		role, err := authService.AuthenticateClaims(claims)
+		if err != nil {
+			c.Next()
+			return
+		}
+
 		// Set user context if valid
 		c.Set("user_id", claims.UserID)
-		c.Set("user_role", claims.Role)
+		c.Set("user_role", role)
 		c.Set("user_email", claims.Email)
+		c.Set("auth_session_id", claims.SessionID)
 
 		c.Next()
 	}
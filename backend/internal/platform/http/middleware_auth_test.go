@@ -4,12 +4,36 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/your-org/ai-tools-atlas-backend/internal/auth"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
 )
 
+// stubUserRepository serves users and sessions from maps; other repository methods are not used by the middleware
+type stubUserRepository struct {
+	auth.Repository
+	users    map[uint]*domain.User
+	sessions map[uint]*domain.Session
+}
+
+func (r *stubUserRepository) GetByID(id uint) (*domain.User, error) {
+	if user, ok := r.users[id]; ok {
+		return user, nil
+	}
+	return nil, auth.ErrUserNotFound
+}
+
+func (r *stubUserRepository) GetSession(id uint) (*domain.Session, error) {
+	if session, ok := r.sessions[id]; ok {
+		return session, nil
+	}
+	return nil, auth.ErrSessionNotFound
+}
+
 func TestMain(m *testing.M) {
 	// Set JWT_SECRET for tests
 	os.Setenv("JWT_SECRET", "test-secret-for-middleware-tests")
@@ -23,7 +47,7 @@ func TestAuthRequired(t *testing.T) {
 
 	t.Run("allows valid token", func(t *testing.T) {
 		// Generate valid token
-		tokenString, _ := authService.GenerateToken(123, "test@example.com", "user")
+		tokenString, _ := authService.GenerateToken(123, "test@example.com", "user", 0)
 
 		// Setup test router
 		router := gin.New()
@@ -95,7 +119,7 @@ func TestAuthRequired(t *testing.T) {
 		userID := uint(789)
 		email := "context@example.com"
 		role := "moderator"
-		tokenString, _ := authService.GenerateToken(userID, email, role)
+		tokenString, _ := authService.GenerateToken(userID, email, role, 0)
 
 		router := gin.New()
 		router.Use(AuthRequired(authService))
@@ -138,141 +162,62 @@ func TestAuthRequired(t *testing.T) {
 	})
 }
 
-func TestAdminRequired(t *testing.T) {
-	t.Run("allows admin role", func(t *testing.T) {
-		router := gin.New()
-		router.Use(func(c *gin.Context) {
-			// Simulate authenticated user with admin role
-			c.Set("user_role", "admin")
-			c.Next()
-		})
-		router.Use(AdminRequired())
-		router.GET("/admin", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{"message": "admin access granted"})
-		})
-
-		req := httptest.NewRequest("GET", "/admin", nil)
-		w := httptest.NewRecorder()
-
-		router.ServeHTTP(w, req)
-
-		if w.Code != http.StatusOK {
-			t.Errorf("Expected status 200, got %d", w.Code)
-		}
+func TestAuthRequiredChecksAccountState(t *testing.T) {
+	suspendedAt := time.Now()
+	revokedAt := time.Now()
+	authService := auth.NewServiceWithRepo(&stubUserRepository{
+		users: map[uint]*domain.User{
+			1: {ID: 1, Role: "moderator"},
+			2: {ID: 2, Role: "user", SuspendedAt: &suspendedAt},
+		},
+		sessions: map[uint]*domain.Session{
+			10: {ID: 10, UserID: 1},
+			11: {ID: 11, UserID: 1, RevokedAt: &revokedAt},
+			12: {ID: 12, UserID: 2},
+		},
 	})
 
-	t.Run("blocks non-admin role", func(t *testing.T) {
-		router := gin.New()
-		router.Use(func(c *gin.Context) {
-			// Simulate authenticated user with user role
-			c.Set("user_role", "user")
-			c.Next()
-		})
-		router.Use(AdminRequired())
-		router.GET("/admin", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{"message": "admin access granted"})
-		})
-
-		req := httptest.NewRequest("GET", "/admin", nil)
-		w := httptest.NewRecorder()
-
-		router.ServeHTTP(w, req)
-
-		if w.Code != http.StatusForbidden {
-			t.Errorf("Expected status 403, got %d", w.Code)
-		}
-	})
-
-	t.Run("blocks moderator role", func(t *testing.T) {
-		router := gin.New()
-		router.Use(func(c *gin.Context) {
-			c.Set("user_role", "moderator")
-			c.Next()
-		})
-		router.Use(AdminRequired())
-		router.GET("/admin", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{"message": "admin access granted"})
-		})
-
-		req := httptest.NewRequest("GET", "/admin", nil)
-		w := httptest.NewRecorder()
-
-		router.ServeHTTP(w, req)
-
-		if w.Code != http.StatusForbidden {
-			t.Errorf("Expected status 403, got %d", w.Code)
-		}
-	})
-
-	t.Run("blocks when role not set in context", func(t *testing.T) {
-		router := gin.New()
-		// No role set in context
-		router.Use(AdminRequired())
-		router.GET("/admin", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{"message": "admin access granted"})
-		})
-
-		req := httptest.NewRequest("GET", "/admin", nil)
-		w := httptest.NewRecorder()
-
-		router.ServeHTTP(w, req)
-
-		if w.Code != http.StatusForbidden {
-			t.Errorf("Expected status 403, got %d", w.Code)
-		}
-	})
-}
-
-func TestAuthRequiredAndAdminRequiredChain(t *testing.T) {
-	authService := auth.NewService()
-
-	t.Run("allows admin user through both middlewares", func(t *testing.T) {
-		// Generate admin token
-		tokenString, _ := authService.GenerateToken(1, "admin@example.com", "admin")
-
-		router := gin.New()
-		router.Use(AuthRequired(authService))
-		router.Use(AdminRequired())
-		router.GET("/admin-only", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{"message": "admin only content"})
-		})
-
-		req := httptest.NewRequest("GET", "/admin-only", nil)
-		req.AddCookie(&http.Cookie{
-			Name:  "auth_token",
-			Value: tokenString,
-		})
-		w := httptest.NewRecorder()
-
-		router.ServeHTTP(w, req)
-
-		if w.Code != http.StatusOK {
-			t.Errorf("Expected status 200, got %d", w.Code)
-		}
-	})
-
-	t.Run("blocks non-admin user", func(t *testing.T) {
-		// Generate user token (not admin)
-		tokenString, _ := authService.GenerateToken(2, "user@example.com", "user")
-
+	newRouter := func() *gin.Engine {
 		router := gin.New()
 		router.Use(AuthRequired(authService))
-		router.Use(AdminRequired())
-		router.GET("/admin-only", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{"message": "admin only content"})
-		})
-
-		req := httptest.NewRequest("GET", "/admin-only", nil)
-		req.AddCookie(&http.Cookie{
-			Name:  "auth_token",
-			Value: tokenString,
+		router.GET("/protected", func(c *gin.Context) {
+			role, _ := c.Get("user_role")
+			c.JSON(http.StatusOK, gin.H{"role": role})
+		})
+		return router
+	}
+
+	tests := []struct {
+		name      string
+		userID    uint
+		sessionID uint
+		role      string
+		expected  int
+	}{
+		{"uses current role from the database", 1, 10, "user", http.StatusOK},
+		{"rejects suspended user with valid token", 2, 12, "user", http.StatusForbidden},
+		{"rejects token for deleted user", 3, 10, "admin", http.StatusUnauthorized},
+		{"rejects token for revoked session", 1, 11, "user", http.StatusUnauthorized},
+		{"rejects token for another user's session", 1, 12, "user", http.StatusUnauthorized},
+		{"rejects token without a session", 1, 0, "user", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenString, _ := authService.GenerateToken(tt.userID, "user@example.com", tt.role, tt.sessionID)
+
+			req := httptest.NewRequest("GET", "/protected", nil)
+			req.AddCookie(&http.Cookie{Name: "auth_token", Value: tokenString})
+			w := httptest.NewRecorder()
+
+			newRouter().ServeHTTP(w, req)
+
+			if w.Code != tt.expected {
+				t.Errorf("Expected status %d, got %d", tt.expected, w.Code)
+			}
+			if tt.expected == http.StatusOK && !strings.Contains(w.Body.String(), "moderator") {
+				t.Errorf("Expected role from the database, got %s", w.Body.String())
+			}
 		})
-		w := httptest.NewRecorder()
-
-		router.ServeHTTP(w, req)
-
-		if w.Code != http.StatusForbidden {
-			t.Errorf("Expected status 403, got %d", w.Code)
-		}
-	})
+	}
 }
@@ -0,0 +1,56 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// Permissions checked by RequirePermission
+const (
+	PermCatalogManage   = "catalog:manage"   // Tool, category, tag, badge and tool owner CRUD
+	PermReviewsModerate = "reviews:moderate" // Moderation queue, reviews and vendor responses
+	PermAnalyticsView   = "analytics:view"   // Admin analytics dashboards
+	PermTrendingManage  = "trending:manage"  // Inspect and recompute trending scores
+	PermUsersManage     = "users:manage"     // List users, change roles and suspend accounts
+)
+
+// rolePermissions maps each role to the permissions it grants
+var rolePermissions = map[string]map[string]bool{
+	"admin": {
+		PermCatalogManage:   true,
+		PermReviewsModerate: true,
+		PermAnalyticsView:   true,
+		PermTrendingManage:  true,
+		PermUsersManage:     true,
+	},
+	"moderator": {
+		PermReviewsModerate: true,
+	},
+	"user": {},
+}
+
+// HasPermission reports whether a role grants a permission
+func HasPermission(role, permission string) bool {
+	return rolePermissions[role][permission]
+}
+
+// RequirePermission middleware checks that the authenticated user's role grants a permission.
+// It must run after AuthRequired, which sets the role in the context.
+func RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, exists := c.Get("user_role")
+		if !exists {
+			ErrorResponse(c, 403, "forbidden", "Access denied: role not found", nil)
+			c.Abort()
+			return
+		}
+
+		roleName, _ := role.(string)
+		if !HasPermission(roleName, permission) {
+			ErrorResponse(c, 403, "forbidden", "Access denied: "+permission+" permission required", nil)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/your-org/ai-tools-atlas-backend/internal/auth"
+)
+
+func TestRequirePermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       string
+		permission string
+		expected   int
+	}{
+		{"admin can manage catalog", "admin", PermCatalogManage, http.StatusOK},
+		{"admin can moderate reviews", "admin", PermReviewsModerate, http.StatusOK},
+		{"moderator can moderate reviews", "moderator", PermReviewsModerate, http.StatusOK},
+		{"moderator cannot manage catalog", "moderator", PermCatalogManage, http.StatusForbidden},
+		{"moderator cannot view analytics", "moderator", PermAnalyticsView, http.StatusForbidden},
+		{"user cannot moderate reviews", "user", PermReviewsModerate, http.StatusForbidden},
+		{"unknown role is denied", "superadmin", PermReviewsModerate, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set("user_role", tt.role)
+				c.Next()
+			})
+			router.Use(RequirePermission(tt.permission))
+			router.GET("/admin", func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"message": "access granted"})
+			})
+
+			req := httptest.NewRequest("GET", "/admin", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.expected {
+				t.Errorf("Expected status %d, got %d", tt.expected, w.Code)
+			}
+		})
+	}
+
+	t.Run("blocks when role not set in context", func(t *testing.T) {
+		router := gin.New()
+		router.Use(RequirePermission(PermReviewsModerate))
+		router.GET("/admin", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"message": "access granted"})
+		})
+
+		req := httptest.NewRequest("GET", "/admin", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("Expected status 403, got %d", w.Code)
+		}
+	})
+}
+
+func TestRequirePermissionPerRouteGroup(t *testing.T) {
+	authService := auth.NewService()
+
+	router := gin.New()
+	admin := router.Group("/admin")
+	admin.Use(AuthRequired(authService))
+	admin.Group("", RequirePermission(PermCatalogManage)).GET("/tools", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	admin.Group("", RequirePermission(PermReviewsModerate)).GET("/moderation/queue", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	tokenString, _ := authService.GenerateToken(3, "mod@example.com", "moderator", 0)
+
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{"/admin/moderation/queue", http.StatusOK},
+		{"/admin/tools", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run("moderator "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			req.AddCookie(&http.Cookie{Name: "auth_token", Value: tokenString})
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.expected {
+				t.Errorf("Expected status %d, got %d", tt.expected, w.Code)
+			}
+		})
+	}
+}
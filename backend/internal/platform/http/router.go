@@ -7,18 +7,23 @@ import (
 	"github.com/your-org/ai-tools-atlas-backend/internal/badges"
 	"github.com/your-org/ai-tools-atlas-backend/internal/bookmarks"
 	"github.com/your-org/ai-tools-atlas-backend/internal/categories"
+	"github.com/your-org/ai-tools-atlas-backend/internal/events"
 	"github.com/your-org/ai-tools-atlas-backend/internal/moderation"
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/config"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/mail"
 	"github.com/your-org/ai-tools-atlas-backend/internal/reviews"
 	"github.com/your-org/ai-tools-atlas-backend/internal/tags"
 	"github.com/your-org/ai-tools-atlas-backend/internal/tools"
+	"github.com/your-org/ai-tools-atlas-backend/internal/trending"
 	"gorm.io/gorm"
 )
 
-// SetupRouter initializes the Gin router with middleware
-func SetupRouter(cfg *config.Config, db *gorm.DB, authService *auth.Service) *gin.Engine {
+// SetupRouter initializes the Gin router with middleware.
+// The auth service backs the auth middlewares and needs a repository to reject suspended users.
+// The trending service is shared with the background job started in main.
+func SetupRouter(cfg *config.Config, db *gorm.DB, authService *auth.Service, trendingService trending.Service) *gin.Engine {
 	// Create router
-	r := gin.New()
+	r := newEngine(cfg)
 
 	// Add middleware
 	r.Use(gin.Recovery())
@@ -34,30 +39,39 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, authService *auth.Service) *gi
 	// Auth middleware for protected routes
 	authMiddleware := AuthRequired(authService)
 	optionalAuthMiddleware := OptionalAuth(authService)
-	adminMiddleware := AdminRequired()
 
 	// Initialize repositories
 	authRepo := auth.NewRepository(db)
 	categoryRepo := categories.NewRepository(db)
 	toolRepo := tools.NewRepository(db)
-	reviewRepo := reviews.NewRepository(db)
+	reviewRepo := reviews.NewRepositoryWithRatingWeight(db, cfg.RatingConfidenceWeight)
 	bookmarkRepo := bookmarks.NewRepository(db)
 	tagRepo := tags.NewRepository(db)
 	badgeRepo := badges.NewRepository(db)
 	analyticsRepo := analytics.NewRepository(db)
 	moderationRepo := moderation.NewRepository(db)
+	eventRepo := events.NewRepository(db)
 
 	// Initialize services
-	// Update auth service with repository for register/login
-	authServiceWithRepo := auth.NewServiceWithRepo(authRepo)
+	// Update auth service with repository for register/login and a mailer for account emails
+	authServiceWithRepo := auth.NewServiceWithMailer(authRepo, mail.New(cfg.Mail), cfg.Mail.AppURL)
 	categoryService := categories.NewService(categoryRepo)
 	toolService := tools.NewService(toolRepo)
-	reviewService := reviews.NewService(reviewRepo)
+	reviewService := reviews.NewServiceWithPipeline(reviewRepo, reviews.NewPipeline(reviews.PipelineConfig{
+		Mode:                  cfg.Moderation.Mode,
+		Rules:                 cfg.Moderation.Rules,
+		ProfanityList:         cfg.Moderation.ProfanityList,
+		ExtremeRatingMinChars: cfg.Moderation.ExtremeRatingMinChars,
+	}))
 	bookmarkService := bookmarks.NewService(bookmarkRepo)
 	tagService := tags.NewService(tagRepo)
 	badgeService := badges.NewService(badgeRepo)
 	analyticsService := analytics.NewService(analyticsRepo)
-	moderationService := moderation.NewService(moderationRepo, reviewRepo)
+	moderationService := moderation.NewServiceWithEscalation(moderationRepo, reviewRepo, moderation.EscalationConfig{
+		Thresholds: cfg.Escalation.Thresholds,
+		Window:     cfg.Escalation.Window,
+	})
+	eventService := events.NewService(eventRepo)
 
 	// Initialize handlers and register routes
 	// Auth handler (with bookmark service for session migration)
@@ -71,30 +85,48 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, authService *auth.Service) *gi
 	toolHandler.RegisterRoutes(v1)
 
 	reviewHandler := reviews.NewHandler(reviewService)
-	reviewHandler.RegisterRoutes(v1, authMiddleware)
+	reviewHandler.RegisterRoutes(v1, authMiddleware, optionalAuthMiddleware)
 
 	bookmarkHandler := bookmarks.NewHandler(bookmarkService)
 	bookmarkHandler.RegisterRoutes(v1, authMiddleware, optionalAuthMiddleware)
 
+	eventHandler := events.NewHandler(eventService)
+	eventHandler.RegisterRoutes(v1, optionalAuthMiddleware)
+
 	tagHandler := tags.NewHandler(tagService)
 	badgeHandler := badges.NewHandler(badgeService)
 	analyticsHandler := analytics.NewHandler(analyticsService)
 	moderationHandler := moderation.NewHandler(moderationService, toolService)
+	trendingHandler := trending.NewHandler(trendingService)
 
 	// Register moderation (reporting) public routes
 	moderationHandler.RegisterRoutes(v1, optionalAuthMiddleware)
 
-	// Admin routes (require authentication + admin role)
+	// Admin routes (require authentication + a permission granted by the user's role)
 	admin := v1.Group("/admin")
-	admin.Use(authMiddleware, adminMiddleware)
+	admin.Use(authMiddleware)
 	{
-		toolHandler.RegisterAdminRoutes(admin)
-		categoryHandler.RegisterAdminRoutes(admin)
-		tagHandler.RegisterAdminRoutes(admin)
-		badgeHandler.RegisterAdminRoutes(admin)
-		analyticsHandler.RegisterAdminRoutes(admin)
-		moderationHandler.RegisterAdminRoutes(admin)
+		catalog := admin.Group("", RequirePermission(PermCatalogManage))
+		toolHandler.RegisterAdminRoutes(catalog)
+		categoryHandler.RegisterAdminRoutes(catalog)
+		tagHandler.RegisterAdminRoutes(catalog)
+		badgeHandler.RegisterAdminRoutes(catalog)
+
+		analyticsHandler.RegisterAdminRoutes(admin.Group("", RequirePermission(PermAnalyticsView)))
+		moderationHandler.RegisterAdminRoutes(admin.Group("", RequirePermission(PermReviewsModerate)))
+		trendingHandler.RegisterAdminRoutes(admin.Group("", RequirePermission(PermTrendingManage)))
+		authHandler.RegisterAdminRoutes(admin.Group("", RequirePermission(PermUsersManage)))
 	}
 
 	return r
 }
+
+// newEngine creates the Gin engine. X-Forwarded-For is only used for the client IP, which keys
+// login throttling and the account email limits, when the request comes from a trusted proxy.
+func newEngine(cfg *config.Config) *gin.Engine {
+	r := gin.New()
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		panic("invalid trusted proxies: " + err.Error()) // config.Load validates them
+	}
+	return r
+}
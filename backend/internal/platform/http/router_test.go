@@ -0,0 +1,81 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/auth"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/config"
+)
+
+// throttleKeyRepository records the client IPs that login throttling is checked and recorded against
+type throttleKeyRepository struct {
+	auth.Repository
+	checkedIPs  []string
+	recordedIPs []string
+}
+
+func (r *throttleKeyRepository) CountEmailLoginFailures(email string, since time.Time, beforeID uint) (*auth.LoginFailures, error) {
+	return &auth.LoginFailures{}, nil
+}
+
+func (r *throttleKeyRepository) CountIPLoginFailures(ip string, since time.Time, beforeID uint) (*auth.LoginFailures, error) {
+	r.checkedIPs = append(r.checkedIPs, ip)
+	return &auth.LoginFailures{}, nil
+}
+
+func (r *throttleKeyRepository) GetByEmail(email string) (*domain.User, error) {
+	return nil, auth.ErrUserNotFound
+}
+
+func (r *throttleKeyRepository) RecordLoginAttempt(attempt *domain.LoginAttempt) error {
+	r.recordedIPs = append(r.recordedIPs, attempt.IPAddress)
+	return nil
+}
+
+func (r *throttleKeyRepository) CompleteLoginAttempt(attempt *domain.LoginAttempt) error {
+	return nil
+}
+
+func TestLoginThrottleKeyIgnoresSpoofedForwardedFor(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		remoteAddr     string
+		expectedIP     string
+	}{
+		{"direct client cannot pick its IP", nil, "203.0.113.7:51234", "203.0.113.7"},
+		{"untrusted proxy cannot pick the IP", []string{"10.0.0.0/8"}, "198.51.100.9:51234", "198.51.100.9"},
+		{"trusted proxy forwards the client IP", []string{"10.0.0.0/8"}, "10.1.2.3:51234", "1.2.3.4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &throttleKeyRepository{}
+			router := newEngine(&config.Config{TrustedProxies: tt.trustedProxies})
+			auth.NewHandler(auth.NewServiceWithRepo(repo), nil).RegisterRoutes(router.Group("/api/v1"), nil)
+
+			req := httptest.NewRequest("POST", "/api/v1/auth/login", strings.NewReader(`{"email":"victim@example.com","password":"guess"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Forwarded-For", "1.2.3.4")
+			req.RemoteAddr = tt.remoteAddr
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusUnauthorized {
+				t.Fatalf("Expected status 401, got %d", w.Code)
+			}
+			if len(repo.checkedIPs) != 1 || repo.checkedIPs[0] != tt.expectedIP {
+				t.Errorf("Expected throttle checked for %s, got %v", tt.expectedIP, repo.checkedIPs)
+			}
+			if len(repo.recordedIPs) != 1 || repo.recordedIPs[0] != tt.expectedIP {
+				t.Errorf("Expected attempt recorded for %s, got %v", tt.expectedIP, repo.recordedIPs)
+			}
+		})
+	}
+}
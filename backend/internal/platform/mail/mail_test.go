@@ -0,0 +1,87 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/config"
+)
+
+func TestLogMailerWritesEmails(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	mailer := NewLogMailer("Atlas <no-reply@example.com>", dir)
+
+	err := mailer.Send(Message{To: "jane@example.com", Subject: "Hello", Body: "Line one\nLine two"})
+	if err != nil {
+		t.Fatalf("Send() failed: %v", err)
+	}
+	if err := mailer.Send(Message{To: "joe@example.com", Subject: "Hi", Body: "Second"}); err != nil {
+		t.Fatalf("Send() failed: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil || len(files) != 2 {
+		t.Fatalf("Expected 2 .eml files, got %v (err %v)", files, err)
+	}
+
+	data, _ := os.ReadFile(files[0])
+	content := string(data)
+	for _, want := range []string{"From: Atlas <no-reply@example.com>\r\n", "To: jane@example.com\r\n", "Subject: Hello\r\n", "\r\n\r\nLine one\r\nLine two"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected email to contain %q, got:\n%s", want, content)
+		}
+	}
+}
+
+func TestLogMailerDoesNotLogBody(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	mailer := NewLogMailer("no-reply@example.com", "")
+	if err := mailer.Send(Message{To: "jane@example.com", Subject: "Reset", Body: "https://atlas.example.com/reset-password?token=secret"}); err != nil {
+		t.Fatalf("Send() failed: %v", err)
+	}
+
+	if !strings.Contains(logged.String(), "to=jane@example.com") {
+		t.Errorf("Expected recipient to be logged, got %q", logged.String())
+	}
+	if strings.Contains(logged.String(), "secret") {
+		t.Errorf("Expected body to be left out of the log, got %q", logged.String())
+	}
+}
+
+func TestSendRejectsHeaderInjection(t *testing.T) {
+	mailer := NewLogMailer("no-reply@example.com", "")
+
+	err := mailer.Send(Message{To: "jane@example.com\r\nBcc: all@example.com", Subject: "Hello"})
+	if !errors.Is(err, ErrInvalidHeader) {
+		t.Errorf("Expected ErrInvalidHeader, got %v", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, ok := New(config.MailConfig{Driver: "log"}).(*LogMailer); !ok {
+		t.Error("Expected log driver to create a LogMailer")
+	}
+	if _, ok := New(config.MailConfig{Driver: "smtp", SMTPHost: "localhost", SMTPPort: 25}).(*SMTPMailer); !ok {
+		t.Error("Expected smtp driver to create an SMTPMailer")
+	}
+}
+
+func TestEnvelopeAddress(t *testing.T) {
+	tests := map[string]string{
+		"AI Tools Atlas <no-reply@example.com>": "no-reply@example.com",
+		"no-reply@example.com":                  "no-reply@example.com",
+	}
+	for from, want := range tests {
+		if got := envelopeAddress(from); got != want {
+			t.Errorf("envelopeAddress(%q) = %q, want %q", from, got, want)
+		}
+	}
+}
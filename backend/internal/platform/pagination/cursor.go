@@ -0,0 +1,219 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrInvalidCursor is returned when a cursor cannot be decoded or does not match the requested sort
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Value kinds for sort keys, used to restore typed values from a decoded cursor
+const (
+	KindInt = iota
+	KindFloat
+	KindTime
+)
+
+// Key is a single ORDER BY term used for keyset pagination
+type Key struct {
+	Expr string        // Column or SQL expression
+	Vars []interface{} // Bind values for placeholders in Expr
+	Desc bool
+	Kind int
+}
+
+// Cursor marks the last row of a page: the active sort, its key values and the row ID
+type Cursor struct {
+	Sort   string        `json:"s"`
+	Values []interface{} `json:"v"`
+	ID     uint          `json:"id"`
+}
+
+// Encode returns the opaque string form of a cursor
+func Encode(sort string, values []interface{}, id uint) string {
+	encoded := make([]interface{}, len(values))
+	for i, v := range values {
+		if t, ok := v.(time.Time); ok {
+			encoded[i] = t.Format(time.RFC3339Nano)
+			continue
+		}
+		encoded[i] = v
+	}
+
+	data, _ := json.Marshal(Cursor{Sort: sort, Values: encoded, ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode parses a cursor string, returning nil when raw is empty.
+// A cursor issued for a different sort is rejected.
+func Decode(raw, sort string) (*Cursor, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Sort != sort || cursor.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// OrderBy returns the ORDER BY clause for the keys followed by the ID tiebreaker
+func OrderBy(keys []Key, idColumn string) clause.OrderBy {
+	terms := make([]string, 0, len(keys)+1)
+	var vars []interface{}
+	for _, k := range keys {
+		terms = append(terms, k.Expr+direction(k.Desc))
+		vars = append(vars, k.Vars...)
+	}
+	terms = append(terms, idColumn+" ASC")
+
+	return clause.OrderBy{Expression: clause.Expr{
+		SQL:                strings.Join(terms, ", "),
+		Vars:               vars,
+		WithoutParentheses: true,
+	}}
+}
+
+// Condition returns the WHERE expression selecting rows that sort after the cursor
+func Condition(keys []Key, idColumn string, cursor *Cursor) (clause.Expr, error) {
+	if len(cursor.Values) != len(keys) {
+		return clause.Expr{}, ErrInvalidCursor
+	}
+
+	values := make([]interface{}, len(keys))
+	for i, k := range keys {
+		v, err := restore(cursor.Values[i], k.Kind)
+		if err != nil {
+			return clause.Expr{}, err
+		}
+		values[i] = v
+	}
+
+	// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... OR (k1 = v1 AND ... AND id > cursor.ID)
+	var branches []string
+	var vars []interface{}
+	for i := 0; i <= len(keys); i++ {
+		var terms []string
+		for j := 0; j < i; j++ {
+			terms = append(terms, keys[j].Expr+" = ?")
+			vars = append(vars, keys[j].Vars...)
+			vars = append(vars, values[j])
+		}
+		if i < len(keys) {
+			op := " > ?"
+			if keys[i].Desc {
+				op = " < ?"
+			}
+			terms = append(terms, keys[i].Expr+op)
+			vars = append(vars, keys[i].Vars...)
+			vars = append(vars, values[i])
+		} else {
+			terms = append(terms, idColumn+" > ?")
+			vars = append(vars, cursor.ID)
+		}
+		branches = append(branches, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	return clause.Expr{SQL: "(" + strings.Join(branches, " OR ") + ")", Vars: vars}, nil
+}
+
+// Values loads the sort key values of a single row, for building the next cursor
+func Values(db *gorm.DB, table string, keys []Key, idColumn string, id uint) ([]interface{}, error) {
+	exprs := make([]string, len(keys))
+	var vars []interface{}
+	dest := make([]interface{}, len(keys))
+	for i, k := range keys {
+		exprs[i] = k.Expr
+		vars = append(vars, k.Vars...)
+		switch k.Kind {
+		case KindInt:
+			dest[i] = new(int64)
+		case KindFloat:
+			dest[i] = new(float64)
+		case KindTime:
+			dest[i] = new(time.Time)
+		}
+	}
+
+	row := db.Table(table).
+		Select(strings.Join(exprs, ", "), vars...).
+		Where(idColumn+" = ?", id).
+		Row()
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(dest))
+	for i, d := range dest {
+		switch v := d.(type) {
+		case *int64:
+			values[i] = *v
+		case *float64:
+			values[i] = *v
+		case *time.Time:
+			values[i] = *v
+		}
+	}
+	return values, nil
+}
+
+// restore converts a JSON-decoded cursor value back to the type of its key
+func restore(value interface{}, kind int) (interface{}, error) {
+	switch kind {
+	case KindTime:
+		s, ok := value.(string)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return t, nil
+	case KindInt:
+		f, ok := value.(float64)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		return int64(f), nil
+	default:
+		f, ok := value.(float64)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		return f, nil
+	}
+}
+
+// direction returns the SQL sort direction suffix
+func direction(desc bool) string {
+	if desc {
+		return " DESC"
+	}
+	return " ASC"
+}
+
+// NextCursor returns the next_cursor meta value, nil when there are no more pages
+func NextCursor(cursor string) interface{} {
+	if cursor == "" {
+		return nil
+	}
+	return cursor
+}
@@ -0,0 +1,84 @@
+package pagination_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
+	"gorm.io/gorm/clause"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	t.Run("decodes what was encoded", func(t *testing.T) {
+		raw := pagination.Encode("top_rated", []interface{}{4.5, int64(12)}, 7)
+
+		cursor, err := pagination.Decode(raw, "top_rated")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "top_rated", cursor.Sort)
+		assert.Equal(t, uint(7), cursor.ID)
+		assert.Equal(t, []interface{}{4.5, float64(12)}, cursor.Values)
+	})
+
+	t.Run("returns nil for an empty cursor", func(t *testing.T) {
+		cursor, err := pagination.Decode("", "top_rated")
+
+		assert.NoError(t, err)
+		assert.Nil(t, cursor)
+	})
+
+	t.Run("rejects a cursor for another sort", func(t *testing.T) {
+		raw := pagination.Encode("newest", []interface{}{time.Now()}, 7)
+
+		_, err := pagination.Decode(raw, "top_rated")
+
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+
+	t.Run("rejects malformed cursors", func(t *testing.T) {
+		_, err := pagination.Decode("not-a-cursor!", "top_rated")
+
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+}
+
+func TestCondition(t *testing.T) {
+	keys := []pagination.Key{
+		{Expr: "rating", Desc: true, Kind: pagination.KindInt},
+		{Expr: "created_at", Desc: true, Kind: pagination.KindTime},
+	}
+
+	t.Run("builds keyset condition with typed values", func(t *testing.T) {
+		createdAt := time.Date(2024, 3, 1, 12, 30, 0, 123456000, time.UTC)
+		cursor, err := pagination.Decode(pagination.Encode("highest", []interface{}{int64(5), createdAt}, 42), "highest")
+		assert.NoError(t, err)
+
+		expr, err := pagination.Condition(keys, "id", cursor)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "((rating < ?) OR (rating = ? AND created_at < ?) OR (rating = ? AND created_at = ? AND id > ?))", expr.SQL)
+		assert.Equal(t, []interface{}{int64(5), int64(5), createdAt, int64(5), createdAt, uint(42)}, expr.Vars)
+	})
+
+	t.Run("rejects cursor with wrong number of values", func(t *testing.T) {
+		cursor, err := pagination.Decode(pagination.Encode("highest", []interface{}{int64(5)}, 42), "highest")
+		assert.NoError(t, err)
+
+		_, err = pagination.Condition(keys, "id", cursor)
+
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+}
+
+func TestOrderBy(t *testing.T) {
+	keys := []pagination.Key{
+		{Expr: "score(?)", Vars: []interface{}{"q"}, Desc: true, Kind: pagination.KindFloat},
+		{Expr: "rating", Kind: pagination.KindInt},
+	}
+
+	orderBy := pagination.OrderBy(keys, "id")
+
+	assert.Equal(t, []interface{}{"q"}, orderBy.Expression.(clause.Expr).Vars)
+	assert.Equal(t, "score(?) DESC, rating ASC, id ASC", orderBy.Expression.(clause.Expr).SQL)
+}
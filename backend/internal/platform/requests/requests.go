@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SessionCookie is the cookie identifying anonymous visitors
+const SessionCookie = "session_id"
+
+// ListParam collects the values of a repeatable, comma-separated query parameter
+func ListParam(c *gin.Context, key string) []string {
+	var values []string
+	for _, raw := range c.QueryArray(key) {
+		for _, part := range strings.Split(raw, ",") {
+			if value := strings.TrimSpace(part); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// NewSessionID generates a new anonymous session ID and sets it as a cookie
+func NewSessionID(c *gin.Context) string {
+	sessionID := uuid.New().String()
+	maxAge := 365 * 24 * 60 * 60 // 1 year
+
+	// Use secure cookies when not in development mode
+	secure := c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+
+	c.SetCookie(
+		SessionCookie,
+		sessionID,
+		maxAge,
+		"/",
+		"",
+		secure,
+		true, // httpOnly - prevent XSS access
+	)
+
+	return sessionID
+}
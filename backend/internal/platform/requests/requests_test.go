@@ -0,0 +1,40 @@
+package requests_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/requests"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestListParam(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/?tag=llm,%20open-source&tag=&tag=agents,,&other=x", nil)
+
+	assert.Equal(t, []string{"llm", "open-source", "agents"}, requests.ListParam(c, "tag"))
+	assert.Nil(t, requests.ListParam(c, "missing"))
+}
+
+func TestNewSessionID(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/", nil)
+	c.Request.Header.Set("X-Forwarded-Proto", "https")
+
+	sessionID := requests.NewSessionID(c)
+
+	cookies := w.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	assert.Equal(t, requests.SessionCookie, cookies[0].Name)
+	assert.Equal(t, sessionID, cookies[0].Value)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.True(t, cookies[0].Secure)
+}
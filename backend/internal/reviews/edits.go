@@ -0,0 +1,148 @@
+package reviews
+
+import (
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+)
+
+// Thresholds above which an edit is substantial enough to need re-moderation
+const (
+	substantialRatingChange = 2   // Stars the overall rating moves by
+	substantialTextRatio    = 0.3 // Share of pros or cons text rewritten
+	substantialTextMinChars = 10  // Minimum characters rewritten, so small typo fixes never count
+)
+
+// applyTo copies the set fields of the input onto the review
+func (in UpdateReviewInput) applyTo(r *domain.Review) {
+	if in.RatingOverall != nil {
+		r.RatingOverall = *in.RatingOverall
+	}
+	if in.RatingEaseOfUse != nil {
+		r.RatingEaseOfUse = in.RatingEaseOfUse
+	}
+	if in.RatingValue != nil {
+		r.RatingValue = in.RatingValue
+	}
+	if in.RatingAccuracy != nil {
+		r.RatingAccuracy = in.RatingAccuracy
+	}
+	if in.RatingSpeed != nil {
+		r.RatingSpeed = in.RatingSpeed
+	}
+	if in.RatingSupport != nil {
+		r.RatingSupport = in.RatingSupport
+	}
+	if in.Pros != nil {
+		r.Pros = *in.Pros
+	}
+	if in.Cons != nil {
+		r.Cons = *in.Cons
+	}
+	if in.PrimaryUseCase != nil {
+		r.PrimaryUseCase = *in.PrimaryUseCase
+	}
+	if in.ReviewerRole != nil {
+		r.ReviewerRole = *in.ReviewerRole
+	}
+	if in.CompanySize != nil {
+		r.CompanySize = *in.CompanySize
+	}
+	if in.UsageContext != nil {
+		r.UsageContext = *in.UsageContext
+	}
+}
+
+// toCreateInput extracts the user-editable content of a review so it can be validated like a new one
+func toCreateInput(r domain.Review) CreateReviewInput {
+	return CreateReviewInput{
+		RatingOverall:   r.RatingOverall,
+		RatingEaseOfUse: r.RatingEaseOfUse,
+		RatingValue:     r.RatingValue,
+		RatingAccuracy:  r.RatingAccuracy,
+		RatingSpeed:     r.RatingSpeed,
+		RatingSupport:   r.RatingSupport,
+		Pros:            r.Pros,
+		Cons:            r.Cons,
+		PrimaryUseCase:  r.PrimaryUseCase,
+		ReviewerRole:    r.ReviewerRole,
+		CompanySize:     r.CompanySize,
+		UsageContext:    r.UsageContext,
+	}
+}
+
+// reviewContentEqual reports whether two versions of a review have the same user-editable content
+func reviewContentEqual(a, b domain.Review) bool {
+	return reflect.DeepEqual(toCreateInput(a), toCreateInput(b))
+}
+
+// newReviewEdit snapshots a review's content before an edit
+func newReviewEdit(r domain.Review) *domain.ReviewEdit {
+	return &domain.ReviewEdit{
+		ReviewID:         r.ID,
+		UserID:           r.UserID,
+		RatingOverall:    r.RatingOverall,
+		RatingEaseOfUse:  r.RatingEaseOfUse,
+		RatingValue:      r.RatingValue,
+		RatingAccuracy:   r.RatingAccuracy,
+		RatingSpeed:      r.RatingSpeed,
+		RatingSupport:    r.RatingSupport,
+		Pros:             r.Pros,
+		Cons:             r.Cons,
+		PrimaryUseCase:   r.PrimaryUseCase,
+		ReviewerRole:     r.ReviewerRole,
+		CompanySize:      r.CompanySize,
+		UsageContext:     r.UsageContext,
+		ModerationStatus: r.ModerationStatus,
+	}
+}
+
+// isSubstantialEdit reports whether an edit changes the overall rating or rewrites
+// enough of the pros or cons that moderators should look at the review again
+func isSubstantialEdit(before, after domain.Review) bool {
+	delta := after.RatingOverall - before.RatingOverall
+	if delta >= substantialRatingChange || -delta >= substantialRatingChange {
+		return true
+	}
+	return substantialTextChange(before.Pros, after.Pros) || substantialTextChange(before.Cons, after.Cons)
+}
+
+// substantialTextChange compares the edit distance between two texts against the thresholds
+func substantialTextChange(before, after string) bool {
+	if before == after {
+		return false
+	}
+
+	distance := editDistance(before, after)
+	longest := utf8.RuneCountInString(before)
+	if n := utf8.RuneCountInString(after); n > longest {
+		longest = n
+	}
+
+	return distance >= substantialTextMinChars && float64(distance) >= substantialTextRatio*float64(longest)
+}
+
+// editDistance returns the Levenshtein distance between two strings, counted in runes
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
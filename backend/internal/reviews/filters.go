@@ -0,0 +1,64 @@
+package reviews
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidRatingRange is returned when min_rating is greater than max_rating
+var ErrInvalidRatingRange = errors.New("min_rating must not be greater than max_rating")
+
+// maxContainsLength caps the pros/cons text search term
+const maxContainsLength = 100
+
+// ReviewFilters defines the available filters for listing a tool's reviews.
+// Multi-value filters match a review if any of their values match, ignoring case.
+type ReviewFilters struct {
+	ReviewerRoles   []string // Exact reviewer_role values
+	CompanySizes    []string // Exact company_size values
+	PrimaryUseCases []string // Exact primary_use_case values
+	MinRating       int      // Minimum overall rating; 0 means no limit
+	MaxRating       int      // Maximum overall rating; 0 means no limit
+	Contains        string   // Text that pros or cons must contain
+	Sort            string   // newest, most_helpful, highest, lowest
+}
+
+// FilterOption is a distinct filter value and the number of reviews that have it
+type FilterOption struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// FilterOptions holds the distinct reviewer context values among a tool's approved reviews
+type FilterOptions struct {
+	ReviewerRoles   []FilterOption `json:"reviewer_role"`
+	CompanySizes    []FilterOption `json:"company_size"`
+	PrimaryUseCases []FilterOption `json:"primary_use_case"`
+}
+
+// normalize drops out-of-range ratings, trims the search term and validates the rating range
+func (f ReviewFilters) normalize() (ReviewFilters, error) {
+	if f.MinRating < 1 || f.MinRating > 5 {
+		f.MinRating = 0
+	}
+	if f.MaxRating < 1 || f.MaxRating > 5 {
+		f.MaxRating = 0
+	}
+	if f.MinRating > 0 && f.MaxRating > 0 && f.MinRating > f.MaxRating {
+		return f, ErrInvalidRatingRange
+	}
+
+	f.Contains = strings.TrimSpace(f.Contains)
+	if utf8.RuneCountInString(f.Contains) > maxContainsLength {
+		f.Contains = string([]rune(f.Contains)[:maxContainsLength])
+	}
+	f.Sort = ValidateSort(f.Sort)
+	return f, nil
+}
+
+// likePattern builds a LIKE pattern matching term anywhere, escaping wildcards
+func likePattern(term string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(term))
+	return "%" + escaped + "%"
+}
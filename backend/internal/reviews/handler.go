@@ -6,6 +6,8 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/requests"
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
 )
 
@@ -20,40 +22,87 @@ func NewHandler(service Service) *Handler {
 }
 
 // RegisterRoutes registers review routes on the given router group
-func (h *Handler) RegisterRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc, optionalAuthMiddleware gin.HandlerFunc) {
 	tools := rg.Group("/tools")
 	{
-		tools.GET("/:slug/reviews", h.ListReviews)
+		tools.GET("/:slug/reviews", optionalAuthMiddleware, h.ListReviews)
+		tools.GET("/:slug/reviews/summary", h.GetReviewSummary)
 		tools.POST("/:slug/reviews", authMiddleware, h.CreateReview)
 	}
 
+	// Helpfulness votes (authenticated users or anonymous sessions)
+	reviews := rg.Group("/reviews")
+	{
+		reviews.POST("/:id/helpful", optionalAuthMiddleware, h.Vote)
+		reviews.DELETE("/:id/helpful", optionalAuthMiddleware, h.RemoveVote)
+		reviews.POST("/:id/response", authMiddleware, h.RespondToReview)
+	}
+
 	// User reviews (authenticated)
-	rg.GET("/me/reviews", authMiddleware, h.GetUserReviews)
+	me := rg.Group("/me")
+	{
+		me.GET("/reviews", authMiddleware, h.GetUserReviews)
+		me.PATCH("/reviews/:id", authMiddleware, h.UpdateReview)
+		me.DELETE("/reviews/:id", authMiddleware, h.DeleteReview)
+	}
 }
 
 // ListReviews handles GET /api/v1/tools/:slug/reviews
 func (h *Handler) ListReviews(c *gin.Context) {
 	slug := c.Param("slug")
 	page, pageSize := h.parsePagination(c)
-	sort := c.DefaultQuery("sort", SortNewest)
+	filters := h.parseFilters(c)
 
-	reviews, total, err := h.service.ListReviews(slug, sort, page, pageSize)
+	viewerID, _ := h.getUserOrSession(c)
+
+	reviews, total, nextCursor, err := h.service.ListReviews(slug, filters, page, pageSize, c.Query("cursor"), viewerID)
 	if err != nil {
 		if errors.Is(err, ErrToolNotFound) {
 			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
 			return
 		}
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			responses.Error(c, http.StatusBadRequest, "INVALID_CURSOR", "Invalid or expired cursor", nil)
+			return
+		}
+		if errors.Is(err, ErrInvalidRatingRange) {
+			responses.Error(c, http.StatusBadRequest, "INVALID_RATING_RANGE", "min_rating must not be greater than max_rating", nil)
+			return
+		}
 		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch reviews", nil)
 		return
 	}
 
+	options, err := h.service.GetFilterOptions(slug)
+	if err != nil {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch review filters", nil)
+		return
+	}
+
 	responses.List(c, reviews, map[string]interface{}{
-		"page":      page,
-		"page_size": pageSize,
-		"total":     total,
+		"page":        page,
+		"page_size":   pageSize,
+		"total":       total,
+		"next_cursor": pagination.NextCursor(nextCursor),
+		"filters":     options,
 	})
 }
 
+// GetReviewSummary handles GET /api/v1/tools/:slug/reviews/summary
+func (h *Handler) GetReviewSummary(c *gin.Context) {
+	summary, err := h.service.GetReviewSummary(c.Param("slug"))
+	if err != nil {
+		if errors.Is(err, ErrToolNotFound) {
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
+			return
+		}
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch review summary", nil)
+		return
+	}
+
+	responses.Success(c, summary)
+}
+
 // CreateReview handles POST /api/v1/tools/:slug/reviews
 func (h *Handler) CreateReview(c *gin.Context) {
 	slug := c.Param("slug")
@@ -85,20 +134,10 @@ func (h *Handler) CreateReview(c *gin.Context) {
 			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
 		case errors.Is(err, ErrAlreadyReviewed):
 			responses.Error(c, http.StatusConflict, "ALREADY_REVIEWED", "You have already reviewed this tool", nil)
-		case errors.Is(err, ErrRatingRequired):
-			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Rating is required", map[string]string{"rating_overall": "required"})
-		case errors.Is(err, ErrInvalidRating):
-			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Rating must be between 1 and 5", map[string]string{"rating": "invalid"})
-		case errors.Is(err, ErrProsRequired):
-			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Pros field is required", map[string]string{"pros": "required"})
-		case errors.Is(err, ErrConsRequired):
-			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Cons field is required", map[string]string{"cons": "required"})
-		case errors.Is(err, ErrProsTooLong):
-			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Pros must be 500 characters or less", map[string]string{"pros": "too_long"})
-		case errors.Is(err, ErrConsTooLong):
-			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Cons must be 500 characters or less", map[string]string{"cons": "too_long"})
 		default:
-			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create review", nil)
+			if !h.writeValidationError(c, err) {
+				responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create review", nil)
+			}
 		}
 		return
 	}
@@ -106,6 +145,110 @@ func (h *Handler) CreateReview(c *gin.Context) {
 	responses.Created(c, review)
 }
 
+// UpdateReview handles PATCH /api/v1/me/reviews/:id
+func (h *Handler) UpdateReview(c *gin.Context) {
+	// Get user ID from auth context
+	userIDVal, exists := c.Get("user_id")
+	if !exists {
+		responses.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required", nil)
+		return
+	}
+	userID, ok := userIDVal.(uint)
+	if !ok {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Invalid user context", nil)
+		return
+	}
+
+	reviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid review id", nil)
+		return
+	}
+
+	var input UpdateReviewInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	review, err := h.service.UpdateReview(uint(reviewID), userID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrReviewNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Review not found", nil)
+		case errors.Is(err, ErrNotReviewOwner):
+			responses.Error(c, http.StatusForbidden, "FORBIDDEN", "You can only edit your own reviews", nil)
+		case errors.Is(err, ErrReviewUnderModeration):
+			responses.Error(c, http.StatusConflict, "REVIEW_UNDER_MODERATION", "The review was moderated or deleted while you were editing it", nil)
+		default:
+			if !h.writeValidationError(c, err) {
+				responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update review", nil)
+			}
+		}
+		return
+	}
+
+	responses.Success(c, review)
+}
+
+// DeleteReview handles DELETE /api/v1/me/reviews/:id
+func (h *Handler) DeleteReview(c *gin.Context) {
+	// Get user ID from auth context
+	userIDVal, exists := c.Get("user_id")
+	if !exists {
+		responses.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required", nil)
+		return
+	}
+	userID, ok := userIDVal.(uint)
+	if !ok {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Invalid user context", nil)
+		return
+	}
+
+	reviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid review id", nil)
+		return
+	}
+
+	if err := h.service.DeleteReview(uint(reviewID), userID); err != nil {
+		switch {
+		case errors.Is(err, ErrReviewNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Review not found", nil)
+		case errors.Is(err, ErrNotReviewOwner):
+			responses.Error(c, http.StatusForbidden, "FORBIDDEN", "You can only delete your own reviews", nil)
+		case errors.Is(err, ErrReviewUnderModeration):
+			responses.Error(c, http.StatusConflict, "REVIEW_UNDER_MODERATION", "Reviews that were rejected or hidden by moderators cannot be deleted", nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete review", nil)
+		}
+		return
+	}
+
+	responses.NoContent(c)
+}
+
+// writeValidationError writes a 422 response for review validation errors and reports whether it did
+func (h *Handler) writeValidationError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, ErrRatingRequired):
+		responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Rating is required", map[string]string{"rating_overall": "required"})
+	case errors.Is(err, ErrInvalidRating):
+		responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Rating must be between 1 and 5", map[string]string{"rating": "invalid"})
+	case errors.Is(err, ErrProsRequired):
+		responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Pros field is required", map[string]string{"pros": "required"})
+	case errors.Is(err, ErrConsRequired):
+		responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Cons field is required", map[string]string{"cons": "required"})
+	case errors.Is(err, ErrProsTooLong):
+		responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Pros must be 500 characters or less", map[string]string{"pros": "too_long"})
+	case errors.Is(err, ErrConsTooLong):
+		responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Cons must be 500 characters or less", map[string]string{"cons": "too_long"})
+	default:
+		return false
+	}
+	return true
+}
+
 // GetUserReviews handles GET /api/v1/me/reviews
 func (h *Handler) GetUserReviews(c *gin.Context) {
 	// Get user ID from auth context
@@ -135,9 +278,150 @@ func (h *Handler) GetUserReviews(c *gin.Context) {
 	})
 }
 
+// Vote handles POST /api/v1/reviews/:id/helpful
+func (h *Handler) Vote(c *gin.Context) {
+	reviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid review id", nil)
+		return
+	}
+
+	// The body is optional; an empty body counts as a helpful vote
+	var input VoteInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", nil)
+			return
+		}
+	}
+	helpful := input.Helpful == nil || *input.Helpful
+
+	userID, sessionID := h.getUserOrSession(c)
+	if userID == 0 && sessionID == "" {
+		sessionID = requests.NewSessionID(c)
+	}
+
+	result, err := h.service.Vote(uint(reviewID), userID, sessionID, helpful)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrReviewNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Review not found", nil)
+		case errors.Is(err, ErrOwnReviewVote):
+			responses.Error(c, http.StatusForbidden, "OWN_REVIEW", "You cannot vote on your own review", nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to record vote", nil)
+		}
+		return
+	}
+
+	responses.Success(c, result)
+}
+
+// RemoveVote handles DELETE /api/v1/reviews/:id/helpful
+func (h *Handler) RemoveVote(c *gin.Context) {
+	reviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid review id", nil)
+		return
+	}
+
+	userID, sessionID := h.getUserOrSession(c)
+
+	result, err := h.service.RemoveVote(uint(reviewID), userID, sessionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrReviewNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Review not found", nil)
+		case errors.Is(err, ErrVoteNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Vote not found", nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to remove vote", nil)
+		}
+		return
+	}
+
+	responses.Success(c, result)
+}
+
+// RespondToReview handles POST /api/v1/reviews/:id/response
+func (h *Handler) RespondToReview(c *gin.Context) {
+	// Get user ID from auth context
+	userIDVal, exists := c.Get("user_id")
+	if !exists {
+		responses.Error(c, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required", nil)
+		return
+	}
+	userID, ok := userIDVal.(uint)
+	if !ok {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Invalid user context", nil)
+		return
+	}
+
+	reviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid review id", nil)
+		return
+	}
+
+	var input VendorResponseInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	reply, err := h.service.RespondToReview(uint(reviewID), userID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrReviewNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Review not found", nil)
+		case errors.Is(err, ErrNotToolOwner):
+			responses.Error(c, http.StatusForbidden, "NOT_TOOL_OWNER", "Only verified owners of this tool can respond", nil)
+		case errors.Is(err, ErrResponseExists):
+			responses.Error(c, http.StatusConflict, "RESPONSE_EXISTS", "This review already has a response", nil)
+		case errors.Is(err, ErrResponseRequired):
+			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Response body is required", map[string]string{"body": "required"})
+		case errors.Is(err, ErrResponseTooLong):
+			responses.Error(c, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Response must be 1000 characters or less", map[string]string{"body": "too_long"})
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create response", nil)
+		}
+		return
+	}
+
+	responses.Created(c, reply)
+}
+
+// getUserOrSession extracts user_id from auth context or session_id from cookie
+func (h *Handler) getUserOrSession(c *gin.Context) (uint, string) {
+	if userIDVal, exists := c.Get("user_id"); exists {
+		if userID, ok := userIDVal.(uint); ok && userID > 0 {
+			return userID, ""
+		}
+	}
+
+	sessionID, _ := c.Cookie(requests.SessionCookie)
+	return 0, sessionID
+}
+
 // parsePagination extracts pagination parameters from the request
 func (h *Handler) parsePagination(c *gin.Context) (int, int) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
 	return page, pageSize
 }
+
+// parseFilters extracts review filters from query parameters; invalid ratings are ignored
+func (h *Handler) parseFilters(c *gin.Context) ReviewFilters {
+	minRating, _ := strconv.Atoi(c.Query("min_rating"))
+	maxRating, _ := strconv.Atoi(c.Query("max_rating"))
+
+	return ReviewFilters{
+		ReviewerRoles:   requests.ListParam(c, "reviewer_role"),
+		CompanySizes:    requests.ListParam(c, "company_size"),
+		PrimaryUseCases: requests.ListParam(c, "primary_use_case"),
+		MinRating:       minRating,
+		MaxRating:       maxRating,
+		Contains:        c.Query("contains"),
+		Sort:            c.DefaultQuery("sort", SortNewest),
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
 	"github.com/your-org/ai-tools-atlas-backend/internal/reviews"
 )
 
@@ -18,12 +19,28 @@ type MockService struct {
 	mock.Mock
 }
 
-func (m *MockService) ListReviews(slug string, sort string, page, pageSize int) ([]reviews.ReviewResponse, int64, error) {
-	args := m.Called(slug, sort, page, pageSize)
+func (m *MockService) ListReviews(slug string, filters reviews.ReviewFilters, page, pageSize int, cursor string, viewerID uint) ([]reviews.ReviewResponse, int64, string, error) {
+	args := m.Called(slug, filters, page, pageSize, cursor, viewerID)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, 0, "", args.Error(3)
+	}
+	return args.Get(0).([]reviews.ReviewResponse), args.Get(1).(int64), args.String(2), args.Error(3)
+}
+
+func (m *MockService) GetFilterOptions(slug string) (*reviews.FilterOptions, error) {
+	args := m.Called(slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
 	}
-	return args.Get(0).([]reviews.ReviewResponse), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).(*reviews.FilterOptions), args.Error(1)
+}
+
+func (m *MockService) GetReviewSummary(slug string) (*reviews.ReviewSummary, error) {
+	args := m.Called(slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reviews.ReviewSummary), args.Error(1)
 }
 
 func (m *MockService) ListUserReviews(userID uint, page, pageSize int) ([]reviews.UserReviewResponse, int64, error) {
@@ -42,6 +59,43 @@ func (m *MockService) CreateReview(slug string, userID uint, input reviews.Creat
 	return args.Get(0).(*reviews.ReviewResponse), args.Error(1)
 }
 
+func (m *MockService) UpdateReview(reviewID, userID uint, input reviews.UpdateReviewInput) (*reviews.ReviewResponse, error) {
+	args := m.Called(reviewID, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reviews.ReviewResponse), args.Error(1)
+}
+
+func (m *MockService) DeleteReview(reviewID, userID uint) error {
+	args := m.Called(reviewID, userID)
+	return args.Error(0)
+}
+
+func (m *MockService) Vote(reviewID, userID uint, sessionID string, helpful bool) (*reviews.VoteResult, error) {
+	args := m.Called(reviewID, userID, sessionID, helpful)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reviews.VoteResult), args.Error(1)
+}
+
+func (m *MockService) RemoveVote(reviewID, userID uint, sessionID string) (*reviews.VoteResult, error) {
+	args := m.Called(reviewID, userID, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reviews.VoteResult), args.Error(1)
+}
+
+func (m *MockService) RespondToReview(reviewID, userID uint, input reviews.VendorResponseInput) (*reviews.VendorReply, error) {
+	args := m.Called(reviewID, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reviews.VendorReply), args.Error(1)
+}
+
 func setupTestRouter(handler *reviews.Handler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
@@ -52,7 +106,15 @@ func setupTestRouter(handler *reviews.Handler) *gin.Engine {
 		c.Next()
 	}
 
-	handler.RegisterRoutes(v1, authMiddleware)
+	// Anonymous unless the request carries an X-Test-User header
+	optionalAuthMiddleware := func(c *gin.Context) {
+		if c.GetHeader("X-Test-User") != "" {
+			c.Set("user_id", uint(1))
+		}
+		c.Next()
+	}
+
+	handler.RegisterRoutes(v1, authMiddleware, optionalAuthMiddleware)
 	return r
 }
 
@@ -69,7 +131,8 @@ func TestListReviews(t *testing.T) {
 			},
 		}
 
-		mockService.On("ListReviews", "chatgpt", "newest", 1, 10).Return(expectedReviews, int64(1), nil)
+		mockService.On("ListReviews", "chatgpt", reviews.ReviewFilters{Sort: "newest"}, 1, 10, "", uint(0)).Return(expectedReviews, int64(1), "", nil)
+		mockService.On("GetFilterOptions", "chatgpt").Return(&reviews.FilterOptions{}, nil)
 
 		handler := reviews.NewHandler(mockService)
 		router := setupTestRouter(handler)
@@ -89,7 +152,7 @@ func TestListReviews(t *testing.T) {
 
 	t.Run("returns 404 for non-existent tool", func(t *testing.T) {
 		mockService := new(MockService)
-		mockService.On("ListReviews", "non-existent", "newest", 1, 10).Return(nil, int64(0), reviews.ErrToolNotFound)
+		mockService.On("ListReviews", "non-existent", reviews.ReviewFilters{Sort: "newest"}, 1, 10, "", uint(0)).Return(nil, int64(0), "", reviews.ErrToolNotFound)
 
 		handler := reviews.NewHandler(mockService)
 		router := setupTestRouter(handler)
@@ -104,7 +167,8 @@ func TestListReviews(t *testing.T) {
 
 	t.Run("handles pagination parameters", func(t *testing.T) {
 		mockService := new(MockService)
-		mockService.On("ListReviews", "chatgpt", "highest", 2, 20).Return([]reviews.ReviewResponse{}, int64(0), nil)
+		mockService.On("ListReviews", "chatgpt", reviews.ReviewFilters{Sort: "highest"}, 2, 20, "", uint(0)).Return([]reviews.ReviewResponse{}, int64(0), "", nil)
+		mockService.On("GetFilterOptions", "chatgpt").Return(&reviews.FilterOptions{}, nil)
 
 		handler := reviews.NewHandler(mockService)
 		router := setupTestRouter(handler)
@@ -116,6 +180,136 @@ func TestListReviews(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("continues from cursor and returns next_cursor", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("ListReviews", "chatgpt", reviews.ReviewFilters{Sort: "most_helpful"}, 1, 10, "abc", uint(0)).Return([]reviews.ReviewResponse{{ID: 4}}, int64(30), "def", nil)
+		mockService.On("GetFilterOptions", "chatgpt").Return(&reviews.FilterOptions{}, nil)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tools/chatgpt/reviews?sort=most_helpful&cursor=abc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		meta := response["meta"].(map[string]interface{})
+		assert.Equal(t, "def", meta["next_cursor"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 400 for invalid cursor", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("ListReviews", "chatgpt", reviews.ReviewFilters{Sort: "newest"}, 1, 10, "bogus", uint(0)).Return(nil, int64(0), "", pagination.ErrInvalidCursor)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tools/chatgpt/reviews?cursor=bogus", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+	t.Run("parses reviewer context filters", func(t *testing.T) {
+		mockService := new(MockService)
+		filters := reviews.ReviewFilters{
+			ReviewerRoles:   []string{"developer", "designer"},
+			CompanySizes:    []string{"1-10"},
+			PrimaryUseCases: []string{"coding"},
+			MinRating:       3,
+			MaxRating:       5,
+			Contains:        "api",
+			Sort:            "newest",
+		}
+		options := &reviews.FilterOptions{
+			ReviewerRoles: []reviews.FilterOption{{Value: "developer", Count: 4}},
+		}
+		mockService.On("ListReviews", "chatgpt", filters, 1, 10, "", uint(0)).Return([]reviews.ReviewResponse{}, int64(0), "", nil)
+		mockService.On("GetFilterOptions", "chatgpt").Return(options, nil)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tools/chatgpt/reviews?reviewer_role=developer,designer&company_size=1-10&primary_use_case=coding&min_rating=3&max_rating=5&contains=api", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		meta := response["meta"].(map[string]interface{})
+		roles := meta["filters"].(map[string]interface{})["reviewer_role"].([]interface{})
+		assert.Len(t, roles, 1)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 400 for inverted rating range", func(t *testing.T) {
+		mockService := new(MockService)
+		filters := reviews.ReviewFilters{MinRating: 5, MaxRating: 2, Sort: "newest"}
+		mockService.On("ListReviews", "chatgpt", filters, 1, 10, "", uint(0)).Return(nil, int64(0), "", reviews.ErrInvalidRatingRange)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tools/chatgpt/reviews?min_rating=5&max_rating=2", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "INVALID_RATING_RANGE")
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetReviewSummary(t *testing.T) {
+	t.Run("returns summary for valid tool slug", func(t *testing.T) {
+		mockService := new(MockService)
+		summary := &reviews.ReviewSummary{
+			ToolID:           1,
+			ReviewCount:      2,
+			AvgRatingOverall: 4.5,
+			Distribution:     []reviews.RatingBucket{{Rating: 5, Count: 1, Percent: 50}, {Rating: 4, Count: 1, Percent: 50}},
+		}
+		mockService.On("GetReviewSummary", "chatgpt").Return(summary, nil)
+
+		router := setupTestRouter(reviews.NewHandler(mockService))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tools/chatgpt/reviews/summary", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		data := response["data"].(map[string]interface{})
+		assert.Equal(t, 4.5, data["avg_rating_overall"])
+		assert.Len(t, data["distribution"], 2)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 404 for non-existent tool", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("GetReviewSummary", "non-existent").Return(nil, reviews.ErrToolNotFound)
+
+		router := setupTestRouter(reviews.NewHandler(mockService))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tools/non-existent/reviews/summary", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
 }
 
 func TestCreateReview(t *testing.T) {
@@ -258,3 +452,251 @@ func TestGetUserReviews(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestVote(t *testing.T) {
+	t.Run("defaults to helpful for signed-in user", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("Vote", uint(10), uint(1), "", true).Return(&reviews.VoteResult{ReviewID: 10, HelpfulCount: 1, MyVote: reviews.VoteHelpful}, nil)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reviews/10/helpful", nil)
+		req.Header.Set("X-Test-User", "1")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("records not helpful vote for anonymous session", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("Vote", uint(10), uint(0), "sess-1", false).Return(&reviews.VoteResult{ReviewID: 10, NotHelpfulCount: 1}, nil)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reviews/10/helpful", bytes.NewBufferString(`{"helpful":false}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "sess-1"})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 403 for own review", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("Vote", uint(10), uint(1), "", true).Return(nil, reviews.ErrOwnReviewVote)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reviews/10/helpful", nil)
+		req.Header.Set("X-Test-User", "1")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("returns 400 for invalid review id", func(t *testing.T) {
+		handler := reviews.NewHandler(new(MockService))
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reviews/abc/helpful", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestRemoveVote(t *testing.T) {
+	t.Run("returns 404 when no vote exists", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("RemoveVote", uint(10), uint(1), "").Return(nil, reviews.ErrVoteNotFound)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/reviews/10/helpful", nil)
+		req.Header.Set("X-Test-User", "1")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestUpdateReview(t *testing.T) {
+	t.Run("updates own review", func(t *testing.T) {
+		mockService := new(MockService)
+		pros := "Even better now"
+		mockService.On("UpdateReview", uint(10), uint(1), reviews.UpdateReviewInput{Pros: &pros}).
+			Return(&reviews.ReviewResponse{ID: 10, Pros: pros, Edited: true, ModerationStatus: "approved"}, nil)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/me/reviews/10", bytes.NewBufferString(`{"pros":"Even better now"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		data := response["data"].(map[string]interface{})
+		assert.Equal(t, true, data["edited"])
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 403 for another user's review", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("UpdateReview", uint(10), uint(1), mock.Anything).Return(nil, reviews.ErrNotReviewOwner)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/me/reviews/10", bytes.NewBufferString(`{"rating_overall":2}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("returns 422 for invalid edit", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("UpdateReview", uint(10), uint(1), mock.Anything).Return(nil, reviews.ErrInvalidRating)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/me/reviews/10", bytes.NewBufferString(`{"rating_overall":9}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("returns 409 when the review was moderated during the edit", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("UpdateReview", uint(10), uint(1), mock.Anything).Return(nil, reviews.ErrReviewUnderModeration)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/me/reviews/10", bytes.NewBufferString(`{"rating_overall":5}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "REVIEW_UNDER_MODERATION")
+	})
+}
+
+func TestDeleteReview(t *testing.T) {
+	t.Run("deletes own review", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("DeleteReview", uint(10), uint(1)).Return(nil)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/me/reviews/10", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 404 for missing review", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("DeleteReview", uint(10), uint(1)).Return(reviews.ErrReviewNotFound)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/me/reviews/10", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("returns 409 for review under moderation", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("DeleteReview", uint(10), uint(1)).Return(reviews.ErrReviewUnderModeration)
+
+		handler := reviews.NewHandler(mockService)
+		router := setupTestRouter(handler)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/me/reviews/10", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "REVIEW_UNDER_MODERATION")
+	})
+}
+
+func TestRespondToReview(t *testing.T) {
+	t.Run("creates pending vendor response", func(t *testing.T) {
+		mockService := new(MockService)
+		input := reviews.VendorResponseInput{Body: "Thanks, we fixed the export bug."}
+		mockService.On("RespondToReview", uint(10), uint(1), input).Return(&reviews.VendorReply{
+			ID:               5,
+			ReviewID:         10,
+			Body:             input.Body,
+			ModerationStatus: "pending",
+		}, nil)
+
+		router := setupTestRouter(reviews.NewHandler(mockService))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reviews/10/response", bytes.NewBufferString(`{"body":"Thanks, we fixed the export bug."}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 403 for users who do not own the tool", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("RespondToReview", uint(10), uint(1), mock.Anything).Return(nil, reviews.ErrNotToolOwner)
+
+		router := setupTestRouter(reviews.NewHandler(mockService))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reviews/10/response", bytes.NewBufferString(`{"body":"Hi"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("returns 409 when the review already has a response", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("RespondToReview", uint(10), uint(1), mock.Anything).Return(nil, reviews.ErrResponseExists)
+
+		router := setupTestRouter(reviews.NewHandler(mockService))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reviews/10/response", bytes.NewBufferString(`{"body":"Hi again"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,197 @@
+package reviews
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+)
+
+// Pre-moderation modes
+const (
+	ModerationModeApprove = "approve" // Publish every review immediately
+	ModerationModeHold    = "hold"    // Hold every review for a moderator
+	ModerationModeRules   = "rules"   // Hold reviews that match any configured rule
+)
+
+// Pre-moderation rule names
+const (
+	RuleFirstTimeReviewer = "first_time_reviewer"
+	RuleContainsLinks     = "contains_links"
+	RuleProfanity         = "profanity"
+	RuleExtremeRating     = "extreme_rating"
+	RuleHoldAll           = "hold_all"
+	RuleSubstantialEdit   = "substantial_edit"
+)
+
+// holdReportReason is the report reason used to place held reviews in the moderation queue
+const holdReportReason = "pre_moderation"
+
+// PipelineConfig selects the pre-moderation mode and the rules used in rules mode
+type PipelineConfig struct {
+	Mode                  string
+	Rules                 []string
+	ProfanityList         []string
+	ExtremeRatingMinChars int
+}
+
+// Candidate is a review being checked by the pipeline, with context about its author
+type Candidate struct {
+	Review       *domain.Review
+	PriorReviews int64 // Reviews the author has already written
+}
+
+// Rule decides whether a review should be held for a moderator
+type Rule interface {
+	Name() string
+	Hold(c Candidate) bool
+}
+
+// Decision is the pipeline's verdict on a review
+type Decision struct {
+	Hold    bool
+	Reasons []string // Names of the rules that held the review
+}
+
+// Status returns the moderation status a review gets from the decision
+func (d Decision) Status() string {
+	if d.Hold {
+		return "pending"
+	}
+	return "approved"
+}
+
+// Pipeline runs new and edited reviews through pre-moderation rules
+type Pipeline struct {
+	holdAll bool
+	rules   []Rule
+}
+
+// NewPipeline builds a pipeline from configuration. Unknown modes approve everything
+// and unknown rule names are skipped; config.Load rejects both before we get here.
+func NewPipeline(cfg PipelineConfig) *Pipeline {
+	switch cfg.Mode {
+	case ModerationModeHold:
+		return &Pipeline{holdAll: true}
+	case ModerationModeRules:
+		var rules []Rule
+		for _, name := range cfg.Rules {
+			switch name {
+			case RuleFirstTimeReviewer:
+				rules = append(rules, firstTimeReviewerRule{})
+			case RuleContainsLinks:
+				rules = append(rules, containsLinksRule{})
+			case RuleProfanity:
+				rules = append(rules, newProfanityRule(cfg.ProfanityList))
+			case RuleExtremeRating:
+				rules = append(rules, extremeRatingRule{minChars: cfg.ExtremeRatingMinChars})
+			}
+		}
+		return NewPipelineWithRules(rules...)
+	default:
+		return NewPipelineWithRules()
+	}
+}
+
+// NewPipelineWithRules builds a pipeline that holds reviews matching any of the given rules
+func NewPipelineWithRules(rules ...Rule) *Pipeline {
+	return &Pipeline{rules: rules}
+}
+
+// NeedsReviewHistory reports whether any rule needs the author's prior review count
+func (p *Pipeline) NeedsReviewHistory() bool {
+	for _, rule := range p.rules {
+		if _, ok := rule.(firstTimeReviewerRule); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate runs every rule against the candidate
+func (p *Pipeline) Evaluate(c Candidate) Decision {
+	if p.holdAll {
+		return Decision{Hold: true, Reasons: []string{RuleHoldAll}}
+	}
+
+	var d Decision
+	for _, rule := range p.rules {
+		if rule.Hold(c) {
+			d.Hold = true
+			d.Reasons = append(d.Reasons, rule.Name())
+		}
+	}
+	return d
+}
+
+// firstTimeReviewerRule holds the first review a user writes
+type firstTimeReviewerRule struct{}
+
+func (firstTimeReviewerRule) Name() string { return RuleFirstTimeReviewer }
+
+func (firstTimeReviewerRule) Hold(c Candidate) bool { return c.PriorReviews == 0 }
+
+// linkPattern matches URLs and bare domains such as example.com
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+|\b[a-z0-9-]+\.(com|net|org|io|ai|co|app|dev|xyz|info|biz)\b`)
+
+// containsLinksRule holds reviews whose free text contains links
+type containsLinksRule struct{}
+
+func (containsLinksRule) Name() string { return RuleContainsLinks }
+
+func (containsLinksRule) Hold(c Candidate) bool {
+	return linkPattern.MatchString(reviewText(c.Review))
+}
+
+// profanityRule holds reviews containing a listed word
+type profanityRule struct {
+	words map[string]bool
+}
+
+func newProfanityRule(list []string) profanityRule {
+	words := make(map[string]bool, len(list))
+	for _, w := range list {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			words[w] = true
+		}
+	}
+	return profanityRule{words: words}
+}
+
+func (profanityRule) Name() string { return RuleProfanity }
+
+func (r profanityRule) Hold(c Candidate) bool {
+	if len(r.words) == 0 {
+		return false
+	}
+	words := strings.FieldsFunc(strings.ToLower(reviewText(c.Review)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		if r.words[w] {
+			return true
+		}
+	}
+	return false
+}
+
+// extremeRatingRule holds 1 or 5 star reviews that give little justification
+type extremeRatingRule struct {
+	minChars int
+}
+
+func (extremeRatingRule) Name() string { return RuleExtremeRating }
+
+func (r extremeRatingRule) Hold(c Candidate) bool {
+	if c.Review.RatingOverall != 1 && c.Review.RatingOverall != 5 {
+		return false
+	}
+	return utf8.RuneCountInString(strings.TrimSpace(c.Review.Pros))+utf8.RuneCountInString(strings.TrimSpace(c.Review.Cons)) < r.minChars
+}
+
+// reviewText joins a review's free-text fields for content rules
+func reviewText(r *domain.Review) string {
+	return strings.Join([]string{r.Pros, r.Cons, r.PrimaryUseCase, r.UsageContext}, "\n")
+}
@@ -0,0 +1,88 @@
+package reviews_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/reviews"
+)
+
+func TestPipeline(t *testing.T) {
+	rules := reviews.PipelineConfig{
+		Mode:                  reviews.ModerationModeRules,
+		Rules:                 []string{reviews.RuleFirstTimeReviewer, reviews.RuleContainsLinks, reviews.RuleProfanity, reviews.RuleExtremeRating},
+		ProfanityList:         []string{"Darn"},
+		ExtremeRatingMinChars: 40,
+	}
+	balanced := &domain.Review{
+		RatingOverall: 4,
+		Pros:          "Handles long documents well and cites sources",
+		Cons:          "Occasionally slow during peak hours",
+	}
+
+	t.Run("approve mode publishes everything", func(t *testing.T) {
+		pipeline := reviews.NewPipeline(reviews.PipelineConfig{Mode: reviews.ModerationModeApprove})
+
+		decision := pipeline.Evaluate(reviews.Candidate{Review: &domain.Review{RatingOverall: 1, Pros: "x", Cons: "y"}})
+
+		assert.False(t, decision.Hold)
+		assert.Equal(t, "approved", decision.Status())
+	})
+
+	t.Run("hold mode holds everything", func(t *testing.T) {
+		pipeline := reviews.NewPipeline(reviews.PipelineConfig{Mode: reviews.ModerationModeHold})
+
+		decision := pipeline.Evaluate(reviews.Candidate{Review: balanced, PriorReviews: 3})
+
+		assert.True(t, decision.Hold)
+		assert.Equal(t, "pending", decision.Status())
+		assert.Equal(t, []string{reviews.RuleHoldAll}, decision.Reasons)
+	})
+
+	t.Run("approves reviews that match no rule", func(t *testing.T) {
+		decision := reviews.NewPipeline(rules).Evaluate(reviews.Candidate{Review: balanced, PriorReviews: 3})
+
+		assert.False(t, decision.Hold)
+		assert.Empty(t, decision.Reasons)
+	})
+
+	t.Run("holds first-time reviewers", func(t *testing.T) {
+		decision := reviews.NewPipeline(rules).Evaluate(reviews.Candidate{Review: balanced})
+
+		assert.Equal(t, []string{reviews.RuleFirstTimeReviewer}, decision.Reasons)
+	})
+
+	t.Run("holds reviews with links", func(t *testing.T) {
+		for _, text := range []string{"see https://spam.example", "visit www.deals.test", "try cheap-tools.io today"} {
+			review := *balanced
+			review.Cons = text
+			decision := reviews.NewPipeline(rules).Evaluate(reviews.Candidate{Review: &review, PriorReviews: 3})
+
+			assert.Equal(t, []string{reviews.RuleContainsLinks}, decision.Reasons, text)
+		}
+	})
+
+	t.Run("holds reviews with listed words regardless of case", func(t *testing.T) {
+		review := *balanced
+		review.Pros = "DARN good at summaries"
+		decision := reviews.NewPipeline(rules).Evaluate(reviews.Candidate{Review: &review, PriorReviews: 3})
+
+		assert.Equal(t, []string{reviews.RuleProfanity}, decision.Reasons)
+	})
+
+	t.Run("holds extreme ratings with little text", func(t *testing.T) {
+		review := &domain.Review{RatingOverall: 1, Pros: "None", Cons: "Awful"}
+		decision := reviews.NewPipeline(rules).Evaluate(reviews.Candidate{Review: review, PriorReviews: 3})
+
+		assert.Equal(t, []string{reviews.RuleExtremeRating}, decision.Reasons)
+	})
+
+	t.Run("only needs review history for the first-time rule", func(t *testing.T) {
+		assert.True(t, reviews.NewPipeline(rules).NeedsReviewHistory())
+		assert.False(t, reviews.NewPipeline(reviews.PipelineConfig{
+			Mode:  reviews.ModerationModeRules,
+			Rules: []string{reviews.RuleContainsLinks},
+		}).NeedsReviewHistory())
+	})
+}
@@ -0,0 +1,41 @@
+package reviews
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RatingRefresher rescores Bayesian ratings against a fresh catalog mean
+type RatingRefresher interface {
+	RefreshBayesianRatings() error
+}
+
+// StartRatingRefreshJob refreshes the catalog mean behind Bayesian ratings in the background
+// every interval until ctx is cancelled. The first run starts immediately.
+func StartRatingRefreshJob(ctx context.Context, ratings RatingRefresher, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			runRatingRefresh(ratings)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// runRatingRefresh performs one scheduled refresh, logging failures
+func runRatingRefresh(ratings RatingRefresher) {
+	started := time.Now()
+	if err := ratings.RefreshBayesianRatings(); err != nil {
+		log.Printf("Bayesian rating refresh failed: %v", err)
+		return
+	}
+	log.Printf("Bayesian ratings refreshed in %s", time.Since(started))
+}
@@ -0,0 +1,14 @@
+package reviews
+
+import "math"
+
+// BayesianRating blends a tool's average rating with weight votes at the catalog mean, so tools
+// with few reviews sit near the mean until they earn more. Tools without reviews score 0.
+func BayesianRating(mean, weight, avg float64, reviewCount int64) float64 {
+	if reviewCount <= 0 {
+		return 0
+	}
+	n := float64(reviewCount)
+	score := (weight*mean + avg*n) / (weight + n)
+	return math.Round(score*10000) / 10000
+}
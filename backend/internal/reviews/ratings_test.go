@@ -0,0 +1,33 @@
+package reviews_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/your-org/ai-tools-atlas-backend/internal/reviews"
+)
+
+func TestBayesianRating(t *testing.T) {
+	tests := []struct {
+		name        string
+		mean        float64
+		weight      float64
+		avg         float64
+		reviewCount int64
+		expected    float64
+	}{
+		{"blends average with the prior", 4, 10, 5, 10, 4.5},
+		{"few reviews stay near the mean", 3.8, 10, 5, 1, 3.9091},
+		{"many reviews approach the average", 3.8, 10, 5, 990, 4.988},
+		{"rounds to four decimals", 3, 10, 4, 3, 3.2308},
+		{"zero weight uses the plain average", 3.8, 0, 4.25, 4, 4.25},
+		{"no reviews scores zero", 3.8, 10, 0, 0, 0},
+		{"no reviews with zero weight scores zero", 3.8, 0, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, reviews.BayesianRating(tt.mean, tt.weight, tt.avg, tt.reviewCount))
+		})
+	}
+}
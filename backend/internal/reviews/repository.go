@@ -1,8 +1,15 @@
 package reviews
 
 import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Sort constants for reviews
@@ -15,55 +22,168 @@ const (
 
 // Repository defines the interface for review data operations
 type Repository interface {
-	ListReviewsByTool(toolID uint, sort string, page, pageSize int) ([]domain.Review, int64, error)
+	ListReviewsByTool(toolID uint, filters ReviewFilters, page, pageSize int, cursor string) ([]domain.Review, int64, string, error)
+	GetFilterOptions(toolID uint) (*FilterOptions, error)
 	ListReviewsByUser(userID uint, page, pageSize int) ([]domain.Review, int64, error)
-	CreateReview(review *domain.Review) error
+	CreateReview(review *domain.Review, holdReasons []string) error
 	HasUserReviewed(toolID, userID uint) (bool, error)
+	CountReviewsByUser(userID uint) (int64, error)
 	UpdateToolRatingAggregates(toolID uint) error
+	RefreshBayesianRatings() error
 	GetToolBySlug(slug string) (*domain.Tool, error)
+	GetReviewByID(id uint) (*domain.Review, error)
+	UpdateReview(review *domain.Review, edit *domain.ReviewEdit, holdReasons []string) error
+	DeleteReview(review *domain.Review) error
+	UpsertVote(reviewID, userID uint, sessionID string, helpful bool) (*domain.Review, error)
+	DeleteVote(reviewID, userID uint, sessionID string) (*domain.Review, error)
+	GetVotesByUser(userID uint, reviewIDs []uint) (map[uint]bool, error)
+	IsToolOwner(toolID, userID uint) (bool, error)
+	HasVendorResponse(reviewID uint) (bool, error)
+	CreateVendorResponse(response *domain.VendorResponse) error
 }
 
+// DefaultRatingConfidenceWeight is the number of catalog-average votes blended into a tool's Bayesian rating
+const DefaultRatingConfidenceWeight = 10
+
 // repository implements the Repository interface
 type repository struct {
-	db *gorm.DB
+	db           *gorm.DB
+	ratingWeight float64
 }
 
 // NewRepository creates a new reviews repository
 func NewRepository(db *gorm.DB) Repository {
-	return &repository{db: db}
+	return NewRepositoryWithRatingWeight(db, DefaultRatingConfidenceWeight)
+}
+
+// NewRepositoryWithRatingWeight creates a reviews repository with a custom Bayesian confidence weight
+func NewRepositoryWithRatingWeight(db *gorm.DB, ratingWeight float64) Repository {
+	return &repository{db: db, ratingWeight: ratingWeight}
 }
 
-// ListReviewsByTool returns paginated reviews for a tool
-func (r *repository) ListReviewsByTool(toolID uint, sort string, page, pageSize int) ([]domain.Review, int64, error) {
+// ListReviewsByTool returns paginated, filtered reviews for a tool and the cursor for the next page.
+// When cursor is set, the page starts after the cursor position instead of at the page offset.
+func (r *repository) ListReviewsByTool(toolID uint, filters ReviewFilters, page, pageSize int, rawCursor string) ([]domain.Review, int64, string, error) {
 	var reviews []domain.Review
 	var total int64
+	sort := filters.Sort
 
 	// Base query: only approved reviews
 	query := r.db.Model(&domain.Review{}).
-		Where("tool_id = ? AND moderation_status = ?", toolID, "approved")
+		Where("reviews.tool_id = ? AND reviews.moderation_status = ?", toolID, "approved")
+	query = applyReviewFilters(query, filters)
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
-	// Apply sorting
-	query = r.applySorting(query, sort)
+	keys := reviewSortKeys(sort)
+	cursor, err := pagination.Decode(rawCursor, sort)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if cursor != nil {
+		condition, err := pagination.Condition(keys, "reviews.id", cursor)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		query = query.Where(condition)
+	} else {
+		query = query.Offset((page - 1) * pageSize)
+	}
 
-	// Apply pagination
-	offset := (page - 1) * pageSize
+	// Fetch one extra row to find out whether another page exists
 	query = query.
+		Order(pagination.OrderBy(keys, "reviews.id")).
 		Preload("User", func(db *gorm.DB) *gorm.DB {
 			return db.Select("id", "display_name")
 		}).
-		Limit(pageSize).
-		Offset(offset)
+		Preload("VendorResponse", "moderation_status = ?", "approved").
+		Preload("VendorResponse.User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "display_name")
+		}).
+		Limit(pageSize + 1)
 
 	if err := query.Find(&reviews).Error; err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	if len(reviews) <= pageSize {
+		return reviews, total, "", nil
 	}
 
-	return reviews, total, nil
+	reviews = reviews[:pageSize]
+	last := reviews[len(reviews)-1]
+	values, err := pagination.Values(r.db, "reviews", keys, "reviews.id", last.ID)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return reviews, total, pagination.Encode(sort, values, last.ID), nil
+}
+
+// applyReviewFilters narrows a reviews query by reviewer context, rating range and text
+func applyReviewFilters(query *gorm.DB, filters ReviewFilters) *gorm.DB {
+	if len(filters.ReviewerRoles) > 0 {
+		query = query.Where("LOWER(reviews.reviewer_role) IN ?", lowerAll(filters.ReviewerRoles))
+	}
+	if len(filters.CompanySizes) > 0 {
+		query = query.Where("LOWER(reviews.company_size) IN ?", lowerAll(filters.CompanySizes))
+	}
+	if len(filters.PrimaryUseCases) > 0 {
+		query = query.Where("LOWER(reviews.primary_use_case) IN ?", lowerAll(filters.PrimaryUseCases))
+	}
+	if filters.MinRating > 0 {
+		query = query.Where("reviews.rating_overall >= ?", filters.MinRating)
+	}
+	if filters.MaxRating > 0 {
+		query = query.Where("reviews.rating_overall <= ?", filters.MaxRating)
+	}
+	if filters.Contains != "" {
+		pattern := likePattern(filters.Contains)
+		query = query.Where("(LOWER(reviews.pros) LIKE ? OR LOWER(reviews.cons) LIKE ?)", pattern, pattern)
+	}
+	return query
+}
+
+// lowerAll lowercases each value for case-insensitive matching
+func lowerAll(values []string) []string {
+	lowered := make([]string, len(values))
+	for i, v := range values {
+		lowered[i] = strings.ToLower(v)
+	}
+	return lowered
+}
+
+// GetFilterOptions returns the distinct reviewer context values among a tool's approved reviews,
+// most common first
+func (r *repository) GetFilterOptions(toolID uint) (*FilterOptions, error) {
+	options := &FilterOptions{}
+	dimensions := []struct {
+		column string
+		target *[]FilterOption
+	}{
+		{"reviewer_role", &options.ReviewerRoles},
+		{"company_size", &options.CompanySizes},
+		{"primary_use_case", &options.PrimaryUseCases},
+	}
+
+	for _, d := range dimensions {
+		values := []FilterOption{}
+		err := r.db.Model(&domain.Review{}).
+			Select(d.column+" AS value, COUNT(*) AS count").
+			Where("tool_id = ? AND moderation_status = ?", toolID, "approved").
+			Where(d.column + " IS NOT NULL AND " + d.column + " <> ''").
+			Group(d.column).
+			Order("count DESC, value ASC").
+			Scan(&values).Error
+		if err != nil {
+			return nil, err
+		}
+		*d.target = values
+	}
+
+	return options, nil
 }
 
 // ListReviewsByUser returns paginated reviews by a user with tool info
@@ -71,8 +191,8 @@ func (r *repository) ListReviewsByUser(userID uint, page, pageSize int) ([]domai
 	var reviews []domain.Review
 	var total int64
 
-	// Base query: all reviews by user
-	query := r.db.Model(&domain.Review{}).Where("user_id = ?", userID)
+	// Base query: all reviews by user that they have not deleted
+	query := r.db.Model(&domain.Review{}).Where("user_id = ? AND moderation_status <> ?", userID, "deleted")
 
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
@@ -81,7 +201,7 @@ func (r *repository) ListReviewsByUser(userID uint, page, pageSize int) ([]domai
 
 	// Apply pagination with tool preload
 	offset := (page - 1) * pageSize
-	err := r.db.Where("user_id = ?", userID).
+	err := r.db.Where("user_id = ? AND moderation_status <> ?", userID, "deleted").
 		Preload("User", func(db *gorm.DB) *gorm.DB {
 			return db.Select("id", "display_name")
 		}).
@@ -100,9 +220,25 @@ func (r *repository) ListReviewsByUser(userID uint, page, pageSize int) ([]domai
 	return reviews, total, nil
 }
 
-// CreateReview inserts a new review
-func (r *repository) CreateReview(review *domain.Review) error {
-	return r.db.Create(review).Error
+// CreateReview inserts a new review. When holdReasons is set the review is also
+// placed in the moderation queue, in the same transaction.
+func (r *repository) CreateReview(review *domain.Review, holdReasons []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(review).Error; err != nil {
+			return err
+		}
+		return createHold(tx, review.ID, holdReasons)
+	})
+}
+
+// CountReviewsByUser returns how many reviews a user has written. Reviews the author deleted are
+// not counted, so posting and deleting a review does not make a reviewer look established.
+func (r *repository) CountReviewsByUser(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.Review{}).
+		Where("user_id = ? AND moderation_status <> ?", userID, "deleted").
+		Count(&count).Error
+	return count, err
 }
 
 // HasUserReviewed checks if a user has already reviewed a tool
@@ -117,31 +253,104 @@ func (r *repository) HasUserReviewed(toolID, userID uint) (bool, error) {
 	return count > 0, nil
 }
 
-// UpdateToolRatingAggregates recalculates avg_rating_overall and review_count for a tool
+// UpdateToolRatingAggregates recalculates the overall and per-dimension averages,
+// the star histogram, review_count and Bayesian rating for a tool. The Bayesian rating
+// uses the stored catalog mean, which RefreshBayesianRatings keeps up to date.
 func (r *repository) UpdateToolRatingAggregates(toolID uint) error {
 	// Calculate aggregates from approved reviews
 	var result struct {
-		AvgRating   float64
-		ReviewCount int64
+		AvgRating    float64
+		AvgEaseOfUse *float64
+		AvgValue     *float64
+		AvgAccuracy  *float64
+		AvgSpeed     *float64
+		AvgSupport   *float64
+		Rating1      int64
+		Rating2      int64
+		Rating3      int64
+		Rating4      int64
+		Rating5      int64
+		ReviewCount  int64
 	}
 
 	err := r.db.Model(&domain.Review{}).
-		Select("COALESCE(AVG(rating_overall), 0) as avg_rating, COUNT(*) as review_count").
+		Select(`COALESCE(AVG(rating_overall), 0) as avg_rating,
+			AVG(rating_ease_of_use) as avg_ease_of_use,
+			AVG(rating_value) as avg_value,
+			AVG(rating_accuracy) as avg_accuracy,
+			AVG(rating_speed) as avg_speed,
+			AVG(rating_support) as avg_support,
+			COUNT(*) FILTER (WHERE rating_overall = 1) as rating1,
+			COUNT(*) FILTER (WHERE rating_overall = 2) as rating2,
+			COUNT(*) FILTER (WHERE rating_overall = 3) as rating3,
+			COUNT(*) FILTER (WHERE rating_overall = 4) as rating4,
+			COUNT(*) FILTER (WHERE rating_overall = 5) as rating5,
+			COUNT(*) as review_count`).
 		Where("tool_id = ? AND moderation_status = ?", toolID, "approved").
 		Scan(&result).Error
 	if err != nil {
 		return err
 	}
 
-	// Update tool
+	var prior struct{ Mean float64 }
+	if err := r.db.Raw("SELECT mean FROM rating_prior").Scan(&prior).Error; err != nil {
+		return err
+	}
+
 	return r.db.Model(&domain.Tool{}).
 		Where("id = ?", toolID).
 		Updates(map[string]interface{}{
-			"avg_rating_overall": result.AvgRating,
-			"review_count":       result.ReviewCount,
+			"avg_rating_overall":     result.AvgRating,
+			"avg_rating_ease_of_use": result.AvgEaseOfUse,
+			"avg_rating_value":       result.AvgValue,
+			"avg_rating_accuracy":    result.AvgAccuracy,
+			"avg_rating_speed":       result.AvgSpeed,
+			"avg_rating_support":     result.AvgSupport,
+			"rating_1_count":         result.Rating1,
+			"rating_2_count":         result.Rating2,
+			"rating_3_count":         result.Rating3,
+			"rating_4_count":         result.Rating4,
+			"rating_5_count":         result.Rating5,
+			"review_count":           result.ReviewCount,
+			"bayesian_rating":        BayesianRating(prior.Mean, r.ratingWeight, result.AvgRating, result.ReviewCount),
 		}).Error
 }
 
+// RefreshBayesianRatings stores the current catalog mean as the Bayesian prior and rescores
+// every tool whose score changed against it. It aggregates every approved review, so it runs
+// on a schedule rather than after each review. Tools without reviews score 0.
+func (r *repository) RefreshBayesianRatings() error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`
+			INSERT INTO rating_prior (id, mean, updated_at)
+			SELECT TRUE, COALESCE(AVG(rating_overall), 0), NOW() FROM reviews WHERE moderation_status = 'approved'
+			ON CONFLICT (id) DO UPDATE SET mean = EXCLUDED.mean, updated_at = EXCLUDED.updated_at
+		`).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			WITH prior AS (
+				SELECT mean FROM rating_prior
+			), stats AS (
+				SELECT tool_id, SUM(rating_overall) AS total, COUNT(*) AS n
+				FROM reviews
+				WHERE moderation_status = 'approved'
+				GROUP BY tool_id
+			), scores AS (
+				SELECT t.id, COALESCE(ROUND((@weight * prior.mean + stats.total) / (@weight + stats.n), 4), 0) AS score
+				FROM tools t
+				CROSS JOIN prior
+				LEFT JOIN stats ON stats.tool_id = t.id
+			)
+			UPDATE tools SET bayesian_rating = scores.score
+			FROM scores
+			WHERE tools.id = scores.id AND tools.bayesian_rating <> scores.score
+		`, map[string]interface{}{"weight": r.ratingWeight}).Error
+	})
+}
+
 // GetToolBySlug finds a tool by its slug
 func (r *repository) GetToolBySlug(slug string) (*domain.Tool, error) {
 	var tool domain.Tool
@@ -152,19 +361,234 @@ func (r *repository) GetToolBySlug(slug string) (*domain.Tool, error) {
 	return &tool, nil
 }
 
-// applySorting adds the appropriate ORDER BY clause
-func (r *repository) applySorting(query *gorm.DB, sort string) *gorm.DB {
+// GetReviewByID finds a review by ID
+func (r *repository) GetReviewByID(id uint) (*domain.Review, error) {
+	var review domain.Review
+	if err := r.db.First(&review, id).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// UpdateReview saves an edited review together with the snapshot of its previous version.
+// The review is only saved while it still has the status recorded in the snapshot, so an edit
+// cannot undo a moderation decision or deletion made in the meantime; ErrReviewUnderModeration
+// is returned instead. When holdReasons is set the review is also placed in the moderation queue.
+func (r *repository) UpdateReview(review *domain.Review, edit *domain.ReviewEdit, holdReasons []string) error {
+	columns := []string{
+		"rating_overall", "rating_ease_of_use", "rating_value", "rating_accuracy",
+		"rating_speed", "rating_support", "pros", "cons", "primary_use_case",
+		"reviewer_role", "company_size", "usage_context", "edited_at", "edit_count",
+	}
+	if len(holdReasons) > 0 {
+		columns = append(columns, "moderation_status", "moderated_by", "moderated_at")
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(review).
+			Where("moderation_status = ?", edit.ModerationStatus).
+			Select(columns).
+			Updates(review)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrReviewUnderModeration
+		}
+		if err := tx.Create(edit).Error; err != nil {
+			return err
+		}
+		return createHold(tx, review.ID, holdReasons)
+	})
+}
+
+// DeleteReview marks a review as deleted by its author. The row is kept with its moderation
+// history, so the author cannot post a new review in its place. Pending reports on the review
+// are dismissed and the deletion is logged in the same transaction.
+func (r *repository) DeleteReview(review *domain.Review) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Review{}).
+			Where("id = ? AND moderation_status = ?", review.ID, review.ModerationStatus).
+			Update("moderation_status", "deleted")
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// A moderator changed the review's status since it was loaded
+			return ErrReviewUnderModeration
+		}
+
+		err := tx.Model(&domain.Report{}).
+			Where("reportable_type = ? AND reportable_id = ? AND status = ?", "review", review.ID, "pending").
+			Updates(map[string]interface{}{
+				"status":      "dismissed",
+				"reviewed_at": time.Now(),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&domain.ModerationAction{
+			ReviewID:    &review.ID,
+			ModeratorID: &review.UserID,
+			ActionType:  "delete",
+			FromStatus:  review.ModerationStatus,
+			ToStatus:    "deleted",
+			Notes:       "Deleted by author",
+		}).Error
+	})
+}
+
+// UpsertVote records or changes a vote and adjusts the review's counters in one transaction.
+// The review row is locked so concurrent votes on the same review are serialised.
+func (r *repository) UpsertVote(reviewID, userID uint, sessionID string, helpful bool) (*domain.Review, error) {
+	var review domain.Review
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockReview(tx, reviewID, &review); err != nil {
+			return err
+		}
+
+		var vote domain.ReviewVote
+		err := voterScope(tx, reviewID, userID, sessionID).First(&vote).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			vote = domain.ReviewVote{ReviewID: reviewID, Helpful: helpful}
+			if userID > 0 {
+				vote.UserID = &userID
+			} else {
+				vote.SessionID = &sessionID
+			}
+			if err := tx.Create(&vote).Error; err != nil {
+				return err
+			}
+			return adjustVoteCounts(tx, &review, helpful, 1)
+		}
+		if err != nil {
+			return err
+		}
+		if vote.Helpful == helpful {
+			return nil
+		}
+
+		if err := tx.Model(&vote).Update("helpful", helpful).Error; err != nil {
+			return err
+		}
+		if err := adjustVoteCounts(tx, &review, vote.Helpful, -1); err != nil {
+			return err
+		}
+		return adjustVoteCounts(tx, &review, helpful, 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// DeleteVote removes a vote and adjusts the review's counters in one transaction.
+// Returns gorm.ErrRecordNotFound if the voter has not voted on the review.
+func (r *repository) DeleteVote(reviewID, userID uint, sessionID string) (*domain.Review, error) {
+	var review domain.Review
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockReview(tx, reviewID, &review); err != nil {
+			return err
+		}
+
+		var vote domain.ReviewVote
+		if err := voterScope(tx, reviewID, userID, sessionID).First(&vote).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&vote).Error; err != nil {
+			return err
+		}
+		return adjustVoteCounts(tx, &review, vote.Helpful, -1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// GetVotesByUser returns the user's votes on the given reviews, keyed by review ID
+func (r *repository) GetVotesByUser(userID uint, reviewIDs []uint) (map[uint]bool, error) {
+	votes := make(map[uint]bool)
+	if len(reviewIDs) == 0 {
+		return votes, nil
+	}
+
+	var rows []domain.ReviewVote
+	err := r.db.Select("review_id", "helpful").
+		Where("user_id = ? AND review_id IN ?", userID, reviewIDs).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range rows {
+		votes[v.ReviewID] = v.Helpful
+	}
+	return votes, nil
+}
+
+// createHold adds a held review to the moderation queue as a system report
+func createHold(tx *gorm.DB, reviewID uint, reasons []string) error {
+	if len(reasons) == 0 {
+		return nil
+	}
+	return tx.Create(&domain.Report{
+		ReportableType: "review",
+		ReportableID:   reviewID,
+		Reason:         holdReportReason,
+		Comment:        "Held by pre-moderation: " + strings.Join(reasons, ", "),
+		Status:         "pending",
+	}).Error
+}
+
+// lockReview loads a review with a row lock for the rest of the transaction
+func lockReview(tx *gorm.DB, reviewID uint, review *domain.Review) error {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(review, reviewID).Error
+}
+
+// voterScope selects the vote cast on a review by a user, or by a session for anonymous voters
+func voterScope(tx *gorm.DB, reviewID, userID uint, sessionID string) *gorm.DB {
+	query := tx.Where("review_id = ?", reviewID)
+	if userID > 0 {
+		return query.Where("user_id = ?", userID)
+	}
+	return query.Where("session_id = ? AND user_id IS NULL", sessionID)
+}
+
+// adjustVoteCounts adds delta to the helpful or not-helpful counter of a locked review
+func adjustVoteCounts(tx *gorm.DB, review *domain.Review, helpful bool, delta int) error {
+	column := "not_helpful_count"
+	if helpful {
+		column = "helpful_count"
+	}
+	if err := tx.Model(&domain.Review{}).Where("id = ?", review.ID).
+		UpdateColumn(column, gorm.Expr(column+" + ?", delta)).Error; err != nil {
+		return err
+	}
+
+	if helpful {
+		review.HelpfulCount += delta
+	} else {
+		review.NotHelpfulCount += delta
+	}
+	return nil
+}
+
+// reviewSortKeys returns the ordering keys for a sort option
+func reviewSortKeys(sort string) []pagination.Key {
+	createdAt := pagination.Key{Expr: "reviews.created_at", Desc: true, Kind: pagination.KindTime}
 	switch sort {
 	case SortMostHelpful:
-		return query.Order("helpful_count DESC, created_at DESC")
+		return []pagination.Key{{Expr: "reviews.helpful_count", Desc: true, Kind: pagination.KindInt}, createdAt}
 	case SortHighest:
-		return query.Order("rating_overall DESC, created_at DESC")
+		return []pagination.Key{{Expr: "reviews.rating_overall", Desc: true, Kind: pagination.KindInt}, createdAt}
 	case SortLowest:
-		return query.Order("rating_overall ASC, created_at DESC")
+		return []pagination.Key{{Expr: "reviews.rating_overall", Kind: pagination.KindInt}, createdAt}
 	case SortNewest:
 		fallthrough
 	default:
-		return query.Order("created_at DESC")
+		return []pagination.Key{createdAt}
 	}
 }
 
@@ -177,3 +601,46 @@ func ValidateSort(sort string) string {
 		return SortNewest
 	}
 }
+
+// IsToolOwner checks whether a user is a verified owner of a tool
+func (r *repository) IsToolOwner(toolID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.ToolOwner{}).
+		Where("tool_id = ? AND user_id = ?", toolID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// HasVendorResponse checks whether a review already has a vendor response, in any moderation state
+func (r *repository) HasVendorResponse(reviewID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.VendorResponse{}).
+		Where("review_id = ?", reviewID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CreateVendorResponse creates a new vendor response
+func (r *repository) CreateVendorResponse(response *domain.VendorResponse) error {
+	err := r.db.Create(response).Error
+	if isUniqueViolation(err) {
+		// Another response to the review was created since HasVendorResponse was checked
+		return ErrResponseExists
+	}
+	return err
+}
+
+// uniqueViolation is the Postgres SQLSTATE for a unique constraint violation
+const uniqueViolation = "23505"
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
@@ -0,0 +1,133 @@
+package reviews
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratepg "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	assert.True(t, isUniqueViolation(&pgconn.PgError{Code: "23505"}))
+	assert.True(t, isUniqueViolation(fmt.Errorf("insert: %w", &pgconn.PgError{Code: "23505"})))
+	assert.False(t, isUniqueViolation(&pgconn.PgError{Code: "23503"}))
+	assert.False(t, isUniqueViolation(assert.AnError))
+	assert.False(t, isUniqueViolation(nil))
+}
+
+// setupRepositoryDB migrates the test database up and rolls it back when the test ends
+func setupRepositoryDB(t *testing.T) *gorm.DB {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping repository tests")
+	}
+
+	sqlDB, err := sql.Open("pgx", dbURL)
+	require.NoError(t, err)
+	driver, err := migratepg.WithInstance(sqlDB, &migratepg.Config{})
+	require.NoError(t, err)
+	m, err := migrate.NewWithDatabaseInstance("file://../../migrations", "postgres", driver)
+	require.NoError(t, err)
+
+	m.Down()
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		t.Fatalf("Migration up failed: %v", err)
+	}
+	t.Cleanup(func() {
+		m.Down()
+		sqlDB.Close()
+	})
+
+	db, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{})
+	require.NoError(t, err)
+	return db
+}
+
+// seedReview creates a tool, an author and a review with the given status, returning the review ID
+func seedReview(t *testing.T, db *gorm.DB, status string) uint {
+	require.NoError(t, db.Exec(`INSERT INTO tools (id, slug, name) VALUES (1, 'tool', 'Tool')`).Error)
+	seedUser(t, db, 1)
+	require.NoError(t, db.Exec(
+		`INSERT INTO reviews (id, tool_id, user_id, rating_overall, moderation_status) VALUES (1, 1, 1, 4, ?)`,
+		status).Error)
+	return 1
+}
+
+func seedUser(t *testing.T, db *gorm.DB, id uint) {
+	require.NoError(t, db.Exec(`INSERT INTO users (id, email, password_hash) VALUES (?, ?, 'x')`,
+		id, fmt.Sprintf("user%d@example.com", id)).Error)
+}
+
+func TestRepositoryUpsertVote(t *testing.T) {
+	db := setupRepositoryDB(t)
+	repo := NewRepository(db)
+	reviewID := seedReview(t, db, "approved")
+	seedUser(t, db, 2)
+	seedUser(t, db, 3)
+
+	t.Run("records votes from several logged-in users on one review", func(t *testing.T) {
+		_, err := repo.UpsertVote(reviewID, 2, "", true)
+		require.NoError(t, err)
+		review, err := repo.UpsertVote(reviewID, 3, "", true)
+		require.NoError(t, err)
+		assert.Equal(t, 2, review.HelpfulCount)
+
+		var nullSessions int64
+		db.Table("review_votes").Where("review_id = ? AND session_id IS NULL", reviewID).Count(&nullSessions)
+		assert.Equal(t, int64(2), nullSessions)
+	})
+
+	t.Run("records anonymous votes by session", func(t *testing.T) {
+		_, err := repo.UpsertVote(reviewID, 0, "sess-1", false)
+		require.NoError(t, err)
+		review, err := repo.UpsertVote(reviewID, 0, "sess-1", false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, review.NotHelpfulCount)
+	})
+}
+
+func TestRepositoryUpdateReview(t *testing.T) {
+	db := setupRepositoryDB(t)
+	repo := NewRepository(db)
+	reviewID := seedReview(t, db, "approved")
+
+	review, err := repo.GetReviewByID(reviewID)
+	require.NoError(t, err)
+	edit := newReviewEdit(*review)
+
+	// A moderator hides the review while the author is editing it
+	require.NoError(t, db.Exec(`UPDATE reviews SET moderation_status = 'hidden' WHERE id = ?`, reviewID).Error)
+
+	review.RatingOverall = 5
+	err = repo.UpdateReview(review, edit, nil)
+	assert.ErrorIs(t, err, ErrReviewUnderModeration)
+
+	saved, err := repo.GetReviewByID(reviewID)
+	require.NoError(t, err)
+	assert.Equal(t, "hidden", saved.ModerationStatus)
+	assert.Equal(t, 4, saved.RatingOverall)
+
+	var edits int64
+	db.Table("review_edits").Where("review_id = ?", reviewID).Count(&edits)
+	assert.Equal(t, int64(0), edits)
+}
+
+func TestRepositoryCountReviewsByUser(t *testing.T) {
+	db := setupRepositoryDB(t)
+	repo := NewRepository(db)
+	seedReview(t, db, "deleted")
+
+	count, err := repo.CountReviewsByUser(1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
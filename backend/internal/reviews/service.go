@@ -2,6 +2,7 @@ package reviews
 
 import (
 	"errors"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -19,8 +20,41 @@ var (
 	ErrProsTooLong       = errors.New("pros must be 500 characters or less")
 	ErrConsTooLong       = errors.New("cons must be 500 characters or less")
 	ErrRatingRequired    = errors.New("rating_overall is required")
+	ErrReviewNotFound    = errors.New("review not found")
+	ErrVoteNotFound      = errors.New("vote not found")
+	ErrOwnReviewVote     = errors.New("cannot vote on your own review")
+	ErrVoterRequired     = errors.New("user_id or session_id required")
+	ErrNotReviewOwner    = errors.New("review belongs to another user")
+	ErrNotToolOwner      = errors.New("user is not a verified owner of this tool")
+	ErrResponseExists    = errors.New("review already has a vendor response")
+	ErrResponseRequired  = errors.New("response body is required")
+	ErrResponseTooLong   = errors.New("response must be 1000 characters or less")
+	ErrReviewUnderModeration = errors.New("review is under moderation and cannot be changed")
 )
 
+// maxResponseLength is the maximum length of a vendor response body in characters
+const maxResponseLength = 1000
+
+// Vote values reported back to the caller
+const (
+	VoteHelpful    = "helpful"
+	VoteNotHelpful = "not_helpful"
+)
+
+// VoteInput represents the request body for voting on a review.
+// Helpful defaults to true when omitted.
+type VoteInput struct {
+	Helpful *bool `json:"helpful"`
+}
+
+// VoteResult represents a review's vote counts after a vote change
+type VoteResult struct {
+	ReviewID        uint   `json:"review_id"`
+	HelpfulCount    int    `json:"helpful_count"`
+	NotHelpfulCount int    `json:"not_helpful_count"`
+	MyVote          string `json:"my_vote,omitempty"`
+}
+
 // CreateReviewInput represents the input for creating a review
 type CreateReviewInput struct {
 	RatingOverall   int    `json:"rating_overall"`
@@ -37,24 +71,62 @@ type CreateReviewInput struct {
 	UsageContext    string `json:"usage_context,omitempty"`
 }
 
+// UpdateReviewInput represents a partial edit of a review; nil fields are left unchanged
+type UpdateReviewInput struct {
+	RatingOverall   *int    `json:"rating_overall,omitempty"`
+	RatingEaseOfUse *int    `json:"rating_ease_of_use,omitempty"`
+	RatingValue     *int    `json:"rating_value,omitempty"`
+	RatingAccuracy  *int    `json:"rating_accuracy,omitempty"`
+	RatingSpeed     *int    `json:"rating_speed,omitempty"`
+	RatingSupport   *int    `json:"rating_support,omitempty"`
+	Pros            *string `json:"pros,omitempty"`
+	Cons            *string `json:"cons,omitempty"`
+	PrimaryUseCase  *string `json:"primary_use_case,omitempty"`
+	ReviewerRole    *string `json:"reviewer_role,omitempty"`
+	CompanySize     *string `json:"company_size,omitempty"`
+	UsageContext    *string `json:"usage_context,omitempty"`
+}
+
+// VendorResponseInput represents the request body for a vendor response
+type VendorResponseInput struct {
+	Body string `json:"body"`
+}
+
+// VendorReply represents a tool owner's response to a review for API response
+type VendorReply struct {
+	ID               uint      `json:"id"`
+	ReviewID         uint      `json:"review_id"`
+	Body             string    `json:"body"`
+	ModerationStatus string    `json:"moderation_status,omitempty"`
+	CreatedAt        string    `json:"created_at"`
+	User             UserBrief `json:"user"`
+}
+
 // ReviewResponse represents a review with user info for API response
 type ReviewResponse struct {
-	ID               uint       `json:"id"`
-	RatingOverall    int        `json:"rating_overall"`
-	RatingEaseOfUse  *int       `json:"rating_ease_of_use,omitempty"`
-	RatingValue      *int       `json:"rating_value,omitempty"`
-	RatingAccuracy   *int       `json:"rating_accuracy,omitempty"`
-	RatingSpeed      *int       `json:"rating_speed,omitempty"`
-	RatingSupport    *int       `json:"rating_support,omitempty"`
-	Pros             string     `json:"pros,omitempty"`
-	Cons             string     `json:"cons,omitempty"`
-	PrimaryUseCase   string     `json:"primary_use_case,omitempty"`
-	ReviewerRole     string     `json:"reviewer_role,omitempty"`
-	CompanySize      string     `json:"company_size,omitempty"`
-	UsageContext     string     `json:"usage_context,omitempty"`
-	HelpfulCount     int        `json:"helpful_count"`
-	CreatedAt        string     `json:"created_at"`
-	User             UserBrief  `json:"user"`
+	ID               uint         `json:"id"`
+	RatingOverall    int          `json:"rating_overall"`
+	RatingEaseOfUse  *int         `json:"rating_ease_of_use,omitempty"`
+	RatingValue      *int         `json:"rating_value,omitempty"`
+	RatingAccuracy   *int         `json:"rating_accuracy,omitempty"`
+	RatingSpeed      *int         `json:"rating_speed,omitempty"`
+	RatingSupport    *int         `json:"rating_support,omitempty"`
+	Pros             string       `json:"pros,omitempty"`
+	Cons             string       `json:"cons,omitempty"`
+	PrimaryUseCase   string       `json:"primary_use_case,omitempty"`
+	ReviewerRole     string       `json:"reviewer_role,omitempty"`
+	CompanySize      string       `json:"company_size,omitempty"`
+	UsageContext     string       `json:"usage_context,omitempty"`
+	HelpfulCount     int          `json:"helpful_count"`
+	NotHelpfulCount  int          `json:"not_helpful_count"`
+	VotedByMe        *bool        `json:"voted_by_me,omitempty"`
+	MyVote           string       `json:"my_vote,omitempty"`
+	ModerationStatus string       `json:"moderation_status,omitempty"`
+	Edited           bool         `json:"edited"`
+	EditedAt         *string      `json:"edited_at,omitempty"`
+	CreatedAt        string       `json:"created_at"`
+	User             UserBrief    `json:"user"`
+	VendorResponse   *VendorReply `json:"vendor_response,omitempty"`
 }
 
 // UserBrief represents minimal user info for review response
@@ -65,13 +137,15 @@ type UserBrief struct {
 
 // UserReviewResponse represents a review with tool info for user profile
 type UserReviewResponse struct {
-	ID               uint   `json:"id"`
-	RatingOverall    int    `json:"rating_overall"`
-	Pros             string `json:"pros,omitempty"`
-	Cons             string `json:"cons,omitempty"`
-	HelpfulCount     int    `json:"helpful_count"`
-	ModerationStatus string `json:"moderation_status"`
-	CreatedAt        string `json:"created_at"`
+	ID               uint    `json:"id"`
+	RatingOverall    int     `json:"rating_overall"`
+	Pros             string  `json:"pros,omitempty"`
+	Cons             string  `json:"cons,omitempty"`
+	HelpfulCount     int     `json:"helpful_count"`
+	ModerationStatus string  `json:"moderation_status"`
+	Edited           bool    `json:"edited"`
+	EditedAt         *string `json:"edited_at,omitempty"`
+	CreatedAt        string  `json:"created_at"`
 	Tool             struct {
 		Slug    string `json:"slug"`
 		Name    string `json:"name"`
@@ -81,40 +155,57 @@ type UserReviewResponse struct {
 
 // Service defines the interface for review business logic
 type Service interface {
-	ListReviews(slug string, sort string, page, pageSize int) ([]ReviewResponse, int64, error)
+	ListReviews(slug string, filters ReviewFilters, page, pageSize int, cursor string, viewerID uint) ([]ReviewResponse, int64, string, error)
+	GetFilterOptions(slug string) (*FilterOptions, error)
+	GetReviewSummary(slug string) (*ReviewSummary, error)
 	ListUserReviews(userID uint, page, pageSize int) ([]UserReviewResponse, int64, error)
 	CreateReview(slug string, userID uint, input CreateReviewInput) (*ReviewResponse, error)
+	UpdateReview(reviewID, userID uint, input UpdateReviewInput) (*ReviewResponse, error)
+	DeleteReview(reviewID, userID uint) error
+	Vote(reviewID, userID uint, sessionID string, helpful bool) (*VoteResult, error)
+	RemoveVote(reviewID, userID uint, sessionID string) (*VoteResult, error)
+	RespondToReview(reviewID, userID uint, input VendorResponseInput) (*VendorReply, error)
 }
 
 // service implements the Service interface
 type service struct {
-	repo Repository
+	repo     Repository
+	pipeline *Pipeline
 }
 
-// NewService creates a new review service
+// NewService creates a new review service that publishes every review immediately
 func NewService(repo Repository) Service {
-	return &service{repo: repo}
+	return NewServiceWithPipeline(repo, NewPipelineWithRules())
+}
+
+// NewServiceWithPipeline creates a new review service that runs new and edited reviews through a pre-moderation pipeline
+func NewServiceWithPipeline(repo Repository, pipeline *Pipeline) Service {
+	return &service{repo: repo, pipeline: pipeline}
 }
 
-// ListReviews returns paginated reviews for a tool
-func (s *service) ListReviews(slug string, sort string, page, pageSize int) ([]ReviewResponse, int64, error) {
+// ListReviews returns paginated, filtered reviews for a tool and the cursor for the next page.
+// When viewerID is set, each review reports whether the viewer has voted on it.
+func (s *service) ListReviews(slug string, filters ReviewFilters, page, pageSize int, cursor string, viewerID uint) ([]ReviewResponse, int64, string, error) {
 	// Validate and set defaults
 	page, pageSize = s.validatePagination(page, pageSize)
-	sort = ValidateSort(sort)
+	filters, err := filters.normalize()
+	if err != nil {
+		return nil, 0, "", err
+	}
 
 	// Get tool by slug
 	tool, err := s.repo.GetToolBySlug(slug)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, 0, ErrToolNotFound
+			return nil, 0, "", ErrToolNotFound
 		}
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	// Get reviews
-	reviews, total, err := s.repo.ListReviewsByTool(tool.ID, sort, page, pageSize)
+	reviews, total, nextCursor, err := s.repo.ListReviewsByTool(tool.ID, filters, page, pageSize, cursor)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	// Convert to response DTOs
@@ -123,7 +214,184 @@ func (s *service) ListReviews(slug string, sort string, page, pageSize int) ([]R
 		responses[i] = s.toReviewResponse(r)
 	}
 
-	return responses, total, nil
+	if viewerID > 0 {
+		if err := s.applyViewerVotes(responses, viewerID); err != nil {
+			return nil, 0, "", err
+		}
+	}
+
+	return responses, total, nextCursor, nil
+}
+
+// GetFilterOptions returns the distinct reviewer context values that reviews of a tool can be filtered by
+func (s *service) GetFilterOptions(slug string) (*FilterOptions, error) {
+	tool, err := s.repo.GetToolBySlug(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrToolNotFound
+		}
+		return nil, err
+	}
+
+	return s.repo.GetFilterOptions(tool.ID)
+}
+
+// GetReviewSummary returns the rating breakdown for a tool from its stored aggregates
+func (s *service) GetReviewSummary(slug string) (*ReviewSummary, error) {
+	tool, err := s.repo.GetToolBySlug(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrToolNotFound
+		}
+		return nil, err
+	}
+
+	return newReviewSummary(tool), nil
+}
+
+// applyViewerVotes sets voted_by_me and my_vote on each review for the viewing user
+func (s *service) applyViewerVotes(responses []ReviewResponse, viewerID uint) error {
+	ids := make([]uint, len(responses))
+	for i, r := range responses {
+		ids[i] = r.ID
+	}
+
+	votes, err := s.repo.GetVotesByUser(viewerID, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range responses {
+		helpful, voted := votes[responses[i].ID]
+		responses[i].VotedByMe = &voted
+		if voted {
+			responses[i].MyVote = voteValue(helpful)
+		}
+	}
+	return nil
+}
+
+// Vote records a helpful or not helpful vote on an approved review.
+// Voting again replaces the previous vote; each user or session has one vote per review.
+func (s *service) Vote(reviewID, userID uint, sessionID string, helpful bool) (*VoteResult, error) {
+	if userID == 0 && sessionID == "" {
+		return nil, ErrVoterRequired
+	}
+
+	review, err := s.getPublishedReview(reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if userID > 0 && review.UserID == userID {
+		return nil, ErrOwnReviewVote
+	}
+
+	updated, err := s.repo.UpsertVote(reviewID, userID, sessionID, helpful)
+	if err != nil {
+		return nil, err
+	}
+
+	return toVoteResult(updated, voteValue(helpful)), nil
+}
+
+// RemoveVote withdraws the caller's vote on a review
+func (s *service) RemoveVote(reviewID, userID uint, sessionID string) (*VoteResult, error) {
+	if userID == 0 && sessionID == "" {
+		return nil, ErrVoteNotFound
+	}
+
+	if _, err := s.getPublishedReview(reviewID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.DeleteVote(reviewID, userID, sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrVoteNotFound
+		}
+		return nil, err
+	}
+
+	return toVoteResult(updated, ""), nil
+}
+
+// RespondToReview posts a tool owner's public response to an approved review.
+// Responses start pending and are published once a moderator approves them.
+func (s *service) RespondToReview(reviewID, userID uint, input VendorResponseInput) (*VendorReply, error) {
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return nil, ErrResponseRequired
+	}
+	if utf8.RuneCountInString(body) > maxResponseLength {
+		return nil, ErrResponseTooLong
+	}
+
+	review, err := s.getPublishedReview(reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner, err := s.repo.IsToolOwner(review.ToolID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner {
+		return nil, ErrNotToolOwner
+	}
+
+	exists, err := s.repo.HasVendorResponse(reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrResponseExists
+	}
+
+	response := &domain.VendorResponse{
+		ReviewID:         reviewID,
+		ToolID:           review.ToolID,
+		UserID:           userID,
+		Body:             body,
+		ModerationStatus: "pending",
+	}
+	if err := s.repo.CreateVendorResponse(response); err != nil {
+		return nil, err
+	}
+
+	return toVendorReply(response), nil
+}
+
+// getPublishedReview returns the review if it exists and has been approved for public display
+func (s *service) getPublishedReview(reviewID uint) (*domain.Review, error) {
+	review, err := s.repo.GetReviewByID(reviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+	if review.ModerationStatus != "approved" {
+		return nil, ErrReviewNotFound
+	}
+	return review, nil
+}
+
+// toVoteResult converts a review's counters to a vote API response
+func toVoteResult(review *domain.Review, myVote string) *VoteResult {
+	return &VoteResult{
+		ReviewID:        review.ID,
+		HelpfulCount:    review.HelpfulCount,
+		NotHelpfulCount: review.NotHelpfulCount,
+		MyVote:          myVote,
+	}
+}
+
+// voteValue converts a stored vote to its API value
+func voteValue(helpful bool) string {
+	if helpful {
+		return VoteHelpful
+	}
+	return VoteNotHelpful
 }
 
 // ListUserReviews returns paginated reviews by a user with tool info
@@ -155,6 +423,8 @@ func (s *service) toUserReviewResponse(r domain.Review) UserReviewResponse {
 		Cons:             r.Cons,
 		HelpfulCount:     r.HelpfulCount,
 		ModerationStatus: r.ModerationStatus,
+		Edited:           r.EditedAt != nil,
+		EditedAt:         formatTime(r.EditedAt),
 		CreatedAt:        r.CreatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 
@@ -193,24 +463,32 @@ func (s *service) CreateReview(slug string, userID uint, input CreateReviewInput
 
 	// Create review
 	review := &domain.Review{
-		ToolID:           tool.ID,
-		UserID:           userID,
-		RatingOverall:    input.RatingOverall,
-		RatingEaseOfUse:  input.RatingEaseOfUse,
-		RatingValue:      input.RatingValue,
-		RatingAccuracy:   input.RatingAccuracy,
-		RatingSpeed:      input.RatingSpeed,
-		RatingSupport:    input.RatingSupport,
-		Pros:             input.Pros,
-		Cons:             input.Cons,
-		PrimaryUseCase:   input.PrimaryUseCase,
-		ReviewerRole:     input.ReviewerRole,
-		CompanySize:      input.CompanySize,
-		UsageContext:     input.UsageContext,
-		ModerationStatus: "approved", // Auto-approve for now (can be configurable)
-	}
-
-	if err := s.repo.CreateReview(review); err != nil {
+		ToolID:          tool.ID,
+		UserID:          userID,
+		RatingOverall:   input.RatingOverall,
+		RatingEaseOfUse: input.RatingEaseOfUse,
+		RatingValue:     input.RatingValue,
+		RatingAccuracy:  input.RatingAccuracy,
+		RatingSpeed:     input.RatingSpeed,
+		RatingSupport:   input.RatingSupport,
+		Pros:            input.Pros,
+		Cons:            input.Cons,
+		PrimaryUseCase:  input.PrimaryUseCase,
+		ReviewerRole:    input.ReviewerRole,
+		CompanySize:     input.CompanySize,
+		UsageContext:    input.UsageContext,
+	}
+
+	candidate := Candidate{Review: review}
+	if s.pipeline.NeedsReviewHistory() {
+		if candidate.PriorReviews, err = s.repo.CountReviewsByUser(userID); err != nil {
+			return nil, err
+		}
+	}
+	decision := s.pipeline.Evaluate(candidate)
+	review.ModerationStatus = decision.Status()
+
+	if err := s.repo.CreateReview(review, decision.Reasons); err != nil {
 		return nil, err
 	}
 
@@ -225,27 +503,136 @@ func (s *service) CreateReview(slug string, userID uint, input CreateReviewInput
 	}
 
 	return &ReviewResponse{
-		ID:              review.ID,
-		RatingOverall:   review.RatingOverall,
-		RatingEaseOfUse: review.RatingEaseOfUse,
-		RatingValue:     review.RatingValue,
-		RatingAccuracy:  review.RatingAccuracy,
-		RatingSpeed:     review.RatingSpeed,
-		RatingSupport:   review.RatingSupport,
-		Pros:            review.Pros,
-		Cons:            review.Cons,
-		PrimaryUseCase:  review.PrimaryUseCase,
-		ReviewerRole:    review.ReviewerRole,
-		CompanySize:     review.CompanySize,
-		UsageContext:    review.UsageContext,
-		HelpfulCount:    review.HelpfulCount,
-		CreatedAt:       createdAt.Format("2006-01-02T15:04:05Z"),
+		ID:               review.ID,
+		RatingOverall:    review.RatingOverall,
+		RatingEaseOfUse:  review.RatingEaseOfUse,
+		RatingValue:      review.RatingValue,
+		RatingAccuracy:   review.RatingAccuracy,
+		RatingSpeed:      review.RatingSpeed,
+		RatingSupport:    review.RatingSupport,
+		Pros:             review.Pros,
+		Cons:             review.Cons,
+		PrimaryUseCase:   review.PrimaryUseCase,
+		ReviewerRole:     review.ReviewerRole,
+		CompanySize:      review.CompanySize,
+		UsageContext:     review.UsageContext,
+		HelpfulCount:     review.HelpfulCount,
+		ModerationStatus: review.ModerationStatus,
+		CreatedAt:        createdAt.Format("2006-01-02T15:04:05Z"),
 		User: UserBrief{
 			ID: userID,
 		},
 	}, nil
 }
 
+// UpdateReview applies the author's edit to a review, keeping the previous version as history.
+// Edits to an approved review send it back to moderation if they are substantial or the
+// pre-moderation pipeline would hold the edited review.
+func (s *service) UpdateReview(reviewID, userID uint, input UpdateReviewInput) (*ReviewResponse, error) {
+	review, err := s.getOwnReview(reviewID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := *review
+	input.applyTo(review)
+	if err := s.validateInput(toCreateInput(*review)); err != nil {
+		return nil, err
+	}
+
+	if reviewContentEqual(previous, *review) {
+		resp := s.toReviewResponse(*review)
+		resp.ModerationStatus = review.ModerationStatus
+		return &resp, nil
+	}
+
+	holdReasons, err := s.editHoldReasons(previous, review)
+	if err != nil {
+		return nil, err
+	}
+	if len(holdReasons) > 0 {
+		review.ModerationStatus = "pending"
+		review.ModeratedBy = nil
+		review.ModeratedAt = nil
+	}
+	now := time.Now().UTC()
+	review.EditedAt = &now
+	review.EditCount++
+
+	if err := s.repo.UpdateReview(review, newReviewEdit(previous), holdReasons); err != nil {
+		return nil, err
+	}
+
+	// Update tool rating aggregates - best effort, as in CreateReview
+	_ = s.repo.UpdateToolRatingAggregates(review.ToolID)
+
+	resp := s.toReviewResponse(*review)
+	resp.ModerationStatus = review.ModerationStatus
+	return &resp, nil
+}
+
+// editHoldReasons runs an edited review through the pre-moderation pipeline and returns why it
+// should be held. Only approved reviews are checked; other reviews are not public anyway.
+func (s *service) editHoldReasons(previous domain.Review, edited *domain.Review) ([]string, error) {
+	if previous.ModerationStatus != "approved" {
+		return nil, nil
+	}
+
+	candidate := Candidate{Review: edited}
+	if s.pipeline.NeedsReviewHistory() {
+		count, err := s.repo.CountReviewsByUser(edited.UserID)
+		if err != nil {
+			return nil, err
+		}
+		// The count includes the review being edited
+		candidate.PriorReviews = max(count-1, 0)
+	}
+
+	reasons := s.pipeline.Evaluate(candidate).Reasons
+	if isSubstantialEdit(previous, *edited) {
+		reasons = append(reasons, RuleSubstantialEdit)
+	}
+	return reasons, nil
+}
+
+// DeleteReview deletes one of the user's own reviews. Rejected and hidden reviews cannot be
+// deleted, so their authors cannot clear the moderation record and post the review again.
+func (s *service) DeleteReview(reviewID, userID uint) error {
+	review, err := s.getOwnReview(reviewID, userID)
+	if err != nil {
+		return err
+	}
+	if review.ModerationStatus != "approved" && review.ModerationStatus != "pending" {
+		return ErrReviewUnderModeration
+	}
+
+	if err := s.repo.DeleteReview(review); err != nil {
+		return err
+	}
+
+	// Update tool rating aggregates - best effort, as in CreateReview
+	_ = s.repo.UpdateToolRatingAggregates(review.ToolID)
+	return nil
+}
+
+// getOwnReview returns a review if it exists, has not been removed or deleted and belongs to the user
+func (s *service) getOwnReview(reviewID, userID uint) (*domain.Review, error) {
+	review, err := s.repo.GetReviewByID(reviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+	if review.ModerationStatus == "removed" || review.ModerationStatus == "deleted" {
+		return nil, ErrReviewNotFound
+	}
+	if review.UserID != userID {
+		return nil, ErrNotReviewOwner
+	}
+	return review, nil
+}
+
 // validateInput validates the review input
 func (s *service) validateInput(input CreateReviewInput) error {
 	// Rating overall is required
@@ -308,6 +695,15 @@ func (s *service) validatePagination(page, pageSize int) (int, int) {
 	return page, pageSize
 }
 
+// formatTime formats an optional timestamp for API responses
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format("2006-01-02T15:04:05Z")
+	return &formatted
+}
+
 // toReviewResponse converts a domain review to API response
 func (s *service) toReviewResponse(r domain.Review) ReviewResponse {
 	resp := ReviewResponse{
@@ -325,6 +721,9 @@ func (s *service) toReviewResponse(r domain.Review) ReviewResponse {
 		CompanySize:     r.CompanySize,
 		UsageContext:    r.UsageContext,
 		HelpfulCount:    r.HelpfulCount,
+		NotHelpfulCount: r.NotHelpfulCount,
+		Edited:          r.EditedAt != nil,
+		EditedAt:        formatTime(r.EditedAt),
 		CreatedAt:       r.CreatedAt.Format("2006-01-02T15:04:05Z"),
 		User: UserBrief{
 			ID: r.UserID,
@@ -336,5 +735,27 @@ func (s *service) toReviewResponse(r domain.Review) ReviewResponse {
 		resp.User.DisplayName = r.User.DisplayName
 	}
 
+	// Only approved vendor responses are public
+	if r.VendorResponse != nil && r.VendorResponse.ModerationStatus == "approved" {
+		reply := toVendorReply(r.VendorResponse)
+		reply.ModerationStatus = ""
+		resp.VendorResponse = reply
+	}
+
 	return resp
 }
+
+// toVendorReply converts a domain vendor response to API response
+func toVendorReply(v *domain.VendorResponse) *VendorReply {
+	return &VendorReply{
+		ID:               v.ID,
+		ReviewID:         v.ReviewID,
+		Body:             v.Body,
+		ModerationStatus: v.ModerationStatus,
+		CreatedAt:        v.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		User: UserBrief{
+			ID:          v.UserID,
+			DisplayName: v.User.DisplayName,
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package reviews_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -16,19 +17,32 @@ type MockRepository struct {
 	mock.Mock
 }
 
-func (m *MockRepository) ListReviewsByTool(toolID uint, sort string, page, pageSize int) ([]domain.Review, int64, error) {
-	args := m.Called(toolID, sort, page, pageSize)
+func (m *MockRepository) ListReviewsByTool(toolID uint, filters reviews.ReviewFilters, page, pageSize int, cursor string) ([]domain.Review, int64, string, error) {
+	args := m.Called(toolID, filters, page, pageSize, cursor)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, 0, "", args.Error(3)
 	}
-	return args.Get(0).([]domain.Review), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).([]domain.Review), args.Get(1).(int64), args.String(2), args.Error(3)
 }
 
-func (m *MockRepository) CreateReview(review *domain.Review) error {
-	args := m.Called(review)
+func (m *MockRepository) GetFilterOptions(toolID uint) (*reviews.FilterOptions, error) {
+	args := m.Called(toolID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reviews.FilterOptions), args.Error(1)
+}
+
+func (m *MockRepository) CreateReview(review *domain.Review, holdReasons []string) error {
+	args := m.Called(review, holdReasons)
 	return args.Error(0)
 }
 
+func (m *MockRepository) CountReviewsByUser(userID uint) (int64, error) {
+	args := m.Called(userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockRepository) HasUserReviewed(toolID, userID uint) (bool, error) {
 	args := m.Called(toolID, userID)
 	return args.Bool(0), args.Error(1)
@@ -39,6 +53,11 @@ func (m *MockRepository) UpdateToolRatingAggregates(toolID uint) error {
 	return args.Error(0)
 }
 
+func (m *MockRepository) RefreshBayesianRatings() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 func (m *MockRepository) GetToolBySlug(slug string) (*domain.Tool, error) {
 	args := m.Called(slug)
 	if args.Get(0) == nil {
@@ -55,6 +74,63 @@ func (m *MockRepository) ListReviewsByUser(userID uint, page, pageSize int) ([]d
 	return args.Get(0).([]domain.Review), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockRepository) GetReviewByID(id uint) (*domain.Review, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockRepository) UpdateReview(review *domain.Review, edit *domain.ReviewEdit, holdReasons []string) error {
+	args := m.Called(review, edit, holdReasons)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteReview(review *domain.Review) error {
+	args := m.Called(review)
+	return args.Error(0)
+}
+
+func (m *MockRepository) UpsertVote(reviewID, userID uint, sessionID string, helpful bool) (*domain.Review, error) {
+	args := m.Called(reviewID, userID, sessionID, helpful)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockRepository) DeleteVote(reviewID, userID uint, sessionID string) (*domain.Review, error) {
+	args := m.Called(reviewID, userID, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockRepository) GetVotesByUser(userID uint, reviewIDs []uint) (map[uint]bool, error) {
+	args := m.Called(userID, reviewIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint]bool), args.Error(1)
+}
+
+func (m *MockRepository) IsToolOwner(toolID, userID uint) (bool, error) {
+	args := m.Called(toolID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) HasVendorResponse(reviewID uint) (bool, error) {
+	args := m.Called(reviewID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) CreateVendorResponse(response *domain.VendorResponse) error {
+	args := m.Called(response)
+	return args.Error(0)
+}
+
 func TestServiceListReviews(t *testing.T) {
 	t.Run("returns reviews for valid tool slug", func(t *testing.T) {
 		mockRepo := new(MockRepository)
@@ -73,10 +149,10 @@ func TestServiceListReviews(t *testing.T) {
 		}
 
 		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
-		mockRepo.On("ListReviewsByTool", uint(1), reviews.SortNewest, 1, 10).Return(expectedReviews, int64(1), nil)
+		mockRepo.On("ListReviewsByTool", uint(1), reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "").Return(expectedReviews, int64(1), "", nil)
 
 		service := reviews.NewService(mockRepo)
-		result, total, err := service.ListReviews("chatgpt", reviews.SortNewest, 1, 10)
+		result, total, _, err := service.ListReviews("chatgpt", reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "", 0)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), total)
@@ -91,7 +167,7 @@ func TestServiceListReviews(t *testing.T) {
 		mockRepo.On("GetToolBySlug", "non-existent").Return(nil, gorm.ErrRecordNotFound)
 
 		service := reviews.NewService(mockRepo)
-		result, total, err := service.ListReviews("non-existent", reviews.SortNewest, 1, 10)
+		result, total, _, err := service.ListReviews("non-existent", reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "", 0)
 
 		assert.Nil(t, result)
 		assert.Equal(t, int64(0), total)
@@ -104,10 +180,10 @@ func TestServiceListReviews(t *testing.T) {
 		tool := &domain.Tool{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}
 
 		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
-		mockRepo.On("ListReviewsByTool", uint(1), reviews.SortNewest, 1, 10).Return([]domain.Review{}, int64(0), nil)
+		mockRepo.On("ListReviewsByTool", uint(1), reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "").Return([]domain.Review{}, int64(0), "", nil)
 
 		service := reviews.NewService(mockRepo)
-		_, _, err := service.ListReviews("chatgpt", "invalid", 1, 10)
+		_, _, _, err := service.ListReviews("chatgpt", reviews.ReviewFilters{Sort: "invalid"}, 1, 10, "", 0)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -118,10 +194,10 @@ func TestServiceListReviews(t *testing.T) {
 		tool := &domain.Tool{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}
 
 		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
-		mockRepo.On("ListReviewsByTool", uint(1), reviews.SortNewest, 1, 10).Return([]domain.Review{}, int64(0), nil)
+		mockRepo.On("ListReviewsByTool", uint(1), reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "").Return([]domain.Review{}, int64(0), "", nil)
 
 		service := reviews.NewService(mockRepo)
-		_, _, err := service.ListReviews("chatgpt", "", 0, 0)
+		_, _, _, err := service.ListReviews("chatgpt", reviews.ReviewFilters{}, 0, 0, "", 0)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -132,14 +208,131 @@ func TestServiceListReviews(t *testing.T) {
 		tool := &domain.Tool{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}
 
 		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
-		mockRepo.On("ListReviewsByTool", uint(1), reviews.SortNewest, 1, 100).Return([]domain.Review{}, int64(0), nil)
+		mockRepo.On("ListReviewsByTool", uint(1), reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 100, "").Return([]domain.Review{}, int64(0), "", nil)
 
 		service := reviews.NewService(mockRepo)
-		_, _, err := service.ListReviews("chatgpt", "", 1, 200)
+		_, _, _, err := service.ListReviews("chatgpt", reviews.ReviewFilters{}, 1, 200, "", 0)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
 	})
+	t.Run("drops out-of-range ratings and trims search term", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		tool := &domain.Tool{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}
+		expected := reviews.ReviewFilters{MaxRating: 4, Contains: "api", Sort: reviews.SortNewest}
+
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
+		mockRepo.On("ListReviewsByTool", uint(1), expected, 1, 10, "").Return([]domain.Review{}, int64(0), "", nil)
+
+		service := reviews.NewService(mockRepo)
+		filters := reviews.ReviewFilters{MinRating: 9, MaxRating: 4, Contains: "  api  "}
+		_, _, _, err := service.ListReviews("chatgpt", filters, 1, 10, "", 0)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns ErrInvalidRatingRange when min exceeds max", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := reviews.NewService(mockRepo)
+		filters := reviews.ReviewFilters{MinRating: 4, MaxRating: 2}
+		_, _, _, err := service.ListReviews("chatgpt", filters, 1, 10, "", 0)
+
+		assert.ErrorIs(t, err, reviews.ErrInvalidRatingRange)
+		mockRepo.AssertNotCalled(t, "ListReviewsByTool")
+	})
+}
+
+func TestServiceGetFilterOptions(t *testing.T) {
+	t.Run("returns filter options for tool", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		tool := &domain.Tool{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}
+		options := &reviews.FilterOptions{
+			CompanySizes: []reviews.FilterOption{{Value: "11-50", Count: 3}},
+		}
+
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
+		mockRepo.On("GetFilterOptions", uint(1)).Return(options, nil)
+
+		service := reviews.NewService(mockRepo)
+		result, err := service.GetFilterOptions("chatgpt")
+
+		assert.NoError(t, err)
+		assert.Equal(t, options, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns ErrToolNotFound when tool not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "non-existent").Return(nil, gorm.ErrRecordNotFound)
+
+		service := reviews.NewService(mockRepo)
+		result, err := service.GetFilterOptions("non-existent")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, reviews.ErrToolNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestServiceGetReviewSummary(t *testing.T) {
+	t.Run("builds summary from stored aggregates", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		speed := 3.5
+		tool := &domain.Tool{
+			ID:               1,
+			Slug:             "chatgpt",
+			AvgRatingOverall: 4.25,
+			AvgSpeed:         &speed,
+			Rating5Count:     2,
+			Rating4Count:     1,
+			Rating1Count:     1,
+			ReviewCount:      4,
+		}
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
+
+		service := reviews.NewService(mockRepo)
+		result, err := service.GetReviewSummary("chatgpt")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result.ReviewCount)
+		assert.Equal(t, 4.25, result.AvgRatingOverall)
+		assert.Equal(t, &speed, result.Dimensions.Speed)
+		assert.Nil(t, result.Dimensions.Support)
+		assert.Equal(t, []reviews.RatingBucket{
+			{Rating: 5, Count: 2, Percent: 50},
+			{Rating: 4, Count: 1, Percent: 25},
+			{Rating: 3, Count: 0, Percent: 0},
+			{Rating: 2, Count: 0, Percent: 0},
+			{Rating: 1, Count: 1, Percent: 25},
+		}, result.Distribution)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns zero percentages for a tool without reviews", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "new-tool").Return(&domain.Tool{ID: 2, Slug: "new-tool"}, nil)
+
+		service := reviews.NewService(mockRepo)
+		result, err := service.GetReviewSummary("new-tool")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Distribution, 5)
+		for _, bucket := range result.Distribution {
+			assert.Zero(t, bucket.Percent)
+		}
+	})
+
+	t.Run("returns ErrToolNotFound when tool not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "missing").Return(nil, gorm.ErrRecordNotFound)
+
+		service := reviews.NewService(mockRepo)
+		_, err := service.GetReviewSummary("missing")
+
+		assert.ErrorIs(t, err, reviews.ErrToolNotFound)
+	})
 }
 
 func TestServiceCreateReview(t *testing.T) {
@@ -149,7 +342,7 @@ func TestServiceCreateReview(t *testing.T) {
 
 		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
 		mockRepo.On("HasUserReviewed", uint(1), uint(1)).Return(false, nil)
-		mockRepo.On("CreateReview", mock.AnythingOfType("*domain.Review")).Return(nil)
+		mockRepo.On("CreateReview", mock.AnythingOfType("*domain.Review"), []string(nil)).Return(nil)
 		mockRepo.On("UpdateToolRatingAggregates", uint(1)).Return(nil)
 
 		service := reviews.NewService(mockRepo)
@@ -166,6 +359,37 @@ func TestServiceCreateReview(t *testing.T) {
 		assert.Equal(t, 5, result.RatingOverall)
 		assert.Equal(t, "Great AI", result.Pros)
 		assert.Equal(t, "Expensive", result.Cons)
+		assert.Equal(t, "approved", result.ModerationStatus)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("holds review matched by the pipeline", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		tool := &domain.Tool{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}
+
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
+		mockRepo.On("HasUserReviewed", uint(1), uint(1)).Return(false, nil)
+		mockRepo.On("CountReviewsByUser", uint(1)).Return(int64(0), nil)
+		mockRepo.On("CreateReview", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.ModerationStatus == "pending"
+		}), []string{reviews.RuleFirstTimeReviewer}).Return(nil)
+		mockRepo.On("UpdateToolRatingAggregates", uint(1)).Return(nil)
+
+		pipeline := reviews.NewPipeline(reviews.PipelineConfig{
+			Mode:  reviews.ModerationModeRules,
+			Rules: []string{reviews.RuleFirstTimeReviewer},
+		})
+		service := reviews.NewServiceWithPipeline(mockRepo, pipeline)
+		input := reviews.CreateReviewInput{
+			RatingOverall: 4,
+			Pros:          "Great AI",
+			Cons:          "Expensive",
+		}
+
+		result, err := service.CreateReview("chatgpt", 1, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", result.ModerationStatus)
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -330,3 +554,432 @@ func TestServiceCreateReview(t *testing.T) {
 		assert.ErrorIs(t, err, reviews.ErrInvalidRating)
 	})
 }
+
+func TestServiceVote(t *testing.T) {
+	t.Run("records helpful vote and returns counts", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(&domain.Review{ID: 10, UserID: 2, ModerationStatus: "approved"}, nil)
+		mockRepo.On("UpsertVote", uint(10), uint(1), "", true).Return(&domain.Review{ID: 10, HelpfulCount: 4, NotHelpfulCount: 1}, nil)
+
+		service := reviews.NewService(mockRepo)
+		result, err := service.Vote(10, 1, "", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result.HelpfulCount)
+		assert.Equal(t, 1, result.NotHelpfulCount)
+		assert.Equal(t, reviews.VoteHelpful, result.MyVote)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("allows anonymous sessions to vote not helpful", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(&domain.Review{ID: 10, UserID: 2, ModerationStatus: "approved"}, nil)
+		mockRepo.On("UpsertVote", uint(10), uint(0), "sess-1", false).Return(&domain.Review{ID: 10, NotHelpfulCount: 1}, nil)
+
+		service := reviews.NewService(mockRepo)
+		result, err := service.Vote(10, 0, "sess-1", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, reviews.VoteNotHelpful, result.MyVote)
+	})
+
+	t.Run("rejects votes on own review", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(&domain.Review{ID: 10, UserID: 1, ModerationStatus: "approved"}, nil)
+
+		service := reviews.NewService(mockRepo)
+		_, err := service.Vote(10, 1, "", true)
+
+		assert.ErrorIs(t, err, reviews.ErrOwnReviewVote)
+		mockRepo.AssertNotCalled(t, "UpsertVote", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("hides unapproved reviews", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(&domain.Review{ID: 10, UserID: 2, ModerationStatus: "pending"}, nil)
+
+		service := reviews.NewService(mockRepo)
+		_, err := service.Vote(10, 1, "", true)
+
+		assert.ErrorIs(t, err, reviews.ErrReviewNotFound)
+	})
+}
+
+func TestServiceRemoveVote(t *testing.T) {
+	t.Run("returns updated counts", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(&domain.Review{ID: 10, UserID: 2, ModerationStatus: "approved"}, nil)
+		mockRepo.On("DeleteVote", uint(10), uint(1), "").Return(&domain.Review{ID: 10, HelpfulCount: 3}, nil)
+
+		service := reviews.NewService(mockRepo)
+		result, err := service.RemoveVote(10, 1, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.HelpfulCount)
+		assert.Empty(t, result.MyVote)
+	})
+
+	t.Run("returns ErrVoteNotFound when caller has not voted", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(&domain.Review{ID: 10, UserID: 2, ModerationStatus: "approved"}, nil)
+		mockRepo.On("DeleteVote", uint(10), uint(1), "").Return(nil, gorm.ErrRecordNotFound)
+
+		service := reviews.NewService(mockRepo)
+		_, err := service.RemoveVote(10, 1, "")
+
+		assert.ErrorIs(t, err, reviews.ErrVoteNotFound)
+	})
+}
+
+func TestServiceListReviewsVotedByMe(t *testing.T) {
+	t.Run("flags reviews the viewer voted on", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(&domain.Tool{ID: 1}, nil)
+		mockRepo.On("ListReviewsByTool", uint(1), reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "").
+			Return([]domain.Review{{ID: 1}, {ID: 2}}, int64(2), "", nil)
+		mockRepo.On("GetVotesByUser", uint(5), []uint{1, 2}).Return(map[uint]bool{2: false}, nil)
+
+		service := reviews.NewService(mockRepo)
+		result, _, _, err := service.ListReviews("chatgpt", reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "", 5)
+
+		assert.NoError(t, err)
+		assert.False(t, *result[0].VotedByMe)
+		assert.True(t, *result[1].VotedByMe)
+		assert.Equal(t, reviews.VoteNotHelpful, result[1].MyVote)
+	})
+
+	t.Run("omits flag for anonymous viewers", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(&domain.Tool{ID: 1}, nil)
+		mockRepo.On("ListReviewsByTool", uint(1), reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "").
+			Return([]domain.Review{{ID: 1}}, int64(1), "", nil)
+
+		service := reviews.NewService(mockRepo)
+		result, _, _, err := service.ListReviews("chatgpt", reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "", 0)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result[0].VotedByMe)
+		mockRepo.AssertNotCalled(t, "GetVotesByUser", mock.Anything, mock.Anything)
+	})
+}
+
+func approvedReview() *domain.Review {
+	return &domain.Review{
+		ID:               10,
+		ToolID:           3,
+		UserID:           1,
+		RatingOverall:    4,
+		Pros:             "Fast and accurate answers",
+		Cons:             "Pricing is a bit high",
+		ModerationStatus: "approved",
+	}
+}
+
+func TestServiceUpdateReview(t *testing.T) {
+	t.Run("keeps approval for minor edits and records history", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("UpdateReview", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.RatingOverall == 5 && r.ModerationStatus == "approved" && r.EditCount == 1 && r.EditedAt != nil
+		}), mock.MatchedBy(func(e *domain.ReviewEdit) bool {
+			return e.ReviewID == 10 && e.RatingOverall == 4 && e.ModerationStatus == "approved"
+		}), []string(nil)).Return(nil)
+		mockRepo.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := reviews.NewService(mockRepo)
+		rating := 5
+		result, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{RatingOverall: &rating})
+
+		assert.NoError(t, err)
+		assert.True(t, result.Edited)
+		assert.Equal(t, "approved", result.ModerationStatus)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("sends substantial edits back to moderation", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("UpdateReview", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.ModerationStatus == "pending"
+		}), mock.Anything, []string{reviews.RuleSubstantialEdit}).Return(nil)
+		mockRepo.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := reviews.NewService(mockRepo)
+		pros := "Completely different opinion after a month of daily use"
+		result, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{Pros: &pros})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", result.ModerationStatus)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("treats large rating swings as substantial", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("UpdateReview", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.ModerationStatus == "pending"
+		}), mock.Anything, []string{reviews.RuleSubstantialEdit}).Return(nil)
+		mockRepo.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := reviews.NewService(mockRepo)
+		rating := 1
+		_, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{RatingOverall: &rating})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("holds minor edits that trip a pipeline rule", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("UpdateReview", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.ModerationStatus == "pending" && r.ModeratedAt == nil
+		}), mock.Anything, []string{reviews.RuleContainsLinks}).Return(nil)
+		mockRepo.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := reviews.NewServiceWithPipeline(mockRepo, reviews.NewPipeline(reviews.PipelineConfig{
+			Mode:  reviews.ModerationModeRules,
+			Rules: []string{reviews.RuleContainsLinks},
+		}))
+		usage := "Daily drafting, compared against cheap-alt.io"
+		result, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{UsageContext: &usage})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", result.ModerationStatus)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("excludes the edited review from the author's history", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("CountReviewsByUser", uint(1)).Return(int64(1), nil)
+		mockRepo.On("UpdateReview", mock.Anything, mock.Anything, []string{reviews.RuleFirstTimeReviewer}).Return(nil)
+		mockRepo.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := reviews.NewServiceWithPipeline(mockRepo, reviews.NewPipeline(reviews.PipelineConfig{
+			Mode:  reviews.ModerationModeRules,
+			Rules: []string{reviews.RuleFirstTimeReviewer},
+		}))
+		rating := 5
+		_, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{RatingOverall: &rating})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not re-run the pipeline on pending reviews", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		review := approvedReview()
+		review.ModerationStatus = "pending"
+		mockRepo.On("GetReviewByID", uint(10)).Return(review, nil)
+		mockRepo.On("UpdateReview", mock.Anything, mock.Anything, []string(nil)).Return(nil)
+		mockRepo.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := reviews.NewServiceWithPipeline(mockRepo, reviews.NewPipeline(reviews.PipelineConfig{Mode: reviews.ModerationModeHold}))
+		rating := 5
+		_, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{RatingOverall: &rating})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns ErrReviewUnderModeration when the review was moderated meanwhile", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("UpdateReview", mock.Anything, mock.MatchedBy(func(e *domain.ReviewEdit) bool {
+			return e.ModerationStatus == "approved"
+		}), []string(nil)).Return(reviews.ErrReviewUnderModeration)
+
+		service := reviews.NewService(mockRepo)
+		rating := 5
+		_, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{RatingOverall: &rating})
+
+		assert.ErrorIs(t, err, reviews.ErrReviewUnderModeration)
+		mockRepo.AssertNotCalled(t, "UpdateToolRatingAggregates", mock.Anything)
+	})
+
+	t.Run("skips saving when nothing changed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+
+		service := reviews.NewService(mockRepo)
+		rating := 4
+		result, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{RatingOverall: &rating})
+
+		assert.NoError(t, err)
+		assert.False(t, result.Edited)
+		mockRepo.AssertNotCalled(t, "UpdateReview", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("applies create validation", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+
+		service := reviews.NewService(mockRepo)
+		empty := ""
+		_, err := service.UpdateReview(10, 1, reviews.UpdateReviewInput{Cons: &empty})
+
+		assert.ErrorIs(t, err, reviews.ErrConsRequired)
+	})
+
+	t.Run("rejects edits to another user's review", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+
+		service := reviews.NewService(mockRepo)
+		rating := 5
+		_, err := service.UpdateReview(10, 2, reviews.UpdateReviewInput{RatingOverall: &rating})
+
+		assert.ErrorIs(t, err, reviews.ErrNotReviewOwner)
+	})
+}
+
+func TestServiceDeleteReview(t *testing.T) {
+	t.Run("deletes review and recomputes aggregates", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("DeleteReview", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.ID == 10 && r.ModerationStatus == "approved"
+		})).Return(nil)
+		mockRepo.On("UpdateToolRatingAggregates", uint(3)).Return(nil)
+
+		service := reviews.NewService(mockRepo)
+		err := service.DeleteReview(10, 1)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns ErrReviewNotFound for missing review", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		service := reviews.NewService(mockRepo)
+		err := service.DeleteReview(99, 1)
+
+		assert.ErrorIs(t, err, reviews.ErrReviewNotFound)
+	})
+
+	t.Run("refuses to delete reviews held back by moderators", func(t *testing.T) {
+		for _, status := range []string{"rejected", "hidden"} {
+			mockRepo := new(MockRepository)
+			review := approvedReview()
+			review.ModerationStatus = status
+			mockRepo.On("GetReviewByID", uint(10)).Return(review, nil)
+
+			service := reviews.NewService(mockRepo)
+			err := service.DeleteReview(10, 1)
+
+			assert.ErrorIs(t, err, reviews.ErrReviewUnderModeration, status)
+			mockRepo.AssertNotCalled(t, "DeleteReview", mock.Anything)
+		}
+	})
+
+	t.Run("treats already deleted review as missing", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		review := approvedReview()
+		review.ModerationStatus = "deleted"
+		mockRepo.On("GetReviewByID", uint(10)).Return(review, nil)
+
+		service := reviews.NewService(mockRepo)
+		err := service.DeleteReview(10, 1)
+
+		assert.ErrorIs(t, err, reviews.ErrReviewNotFound)
+	})
+}
+
+func TestServiceRespondToReview(t *testing.T) {
+	t.Run("creates a pending response for a tool owner", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("IsToolOwner", uint(3), uint(7)).Return(true, nil)
+		mockRepo.On("HasVendorResponse", uint(10)).Return(false, nil)
+		mockRepo.On("CreateVendorResponse", mock.MatchedBy(func(r *domain.VendorResponse) bool {
+			return r.ReviewID == 10 && r.ToolID == 3 && r.UserID == 7 &&
+				r.Body == "Thanks for the feedback" && r.ModerationStatus == "pending"
+		})).Return(nil)
+
+		service := reviews.NewService(mockRepo)
+		result, err := service.RespondToReview(10, 7, reviews.VendorResponseInput{Body: "  Thanks for the feedback  "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", result.ModerationStatus)
+		assert.Equal(t, uint(7), result.User.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects users who do not own the tool", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("IsToolOwner", uint(3), uint(7)).Return(false, nil)
+
+		service := reviews.NewService(mockRepo)
+		_, err := service.RespondToReview(10, 7, reviews.VendorResponseInput{Body: "Hello"})
+
+		assert.ErrorIs(t, err, reviews.ErrNotToolOwner)
+		mockRepo.AssertNotCalled(t, "CreateVendorResponse", mock.Anything)
+	})
+
+	t.Run("allows only one response per review", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetReviewByID", uint(10)).Return(approvedReview(), nil)
+		mockRepo.On("IsToolOwner", uint(3), uint(7)).Return(true, nil)
+		mockRepo.On("HasVendorResponse", uint(10)).Return(true, nil)
+
+		service := reviews.NewService(mockRepo)
+		_, err := service.RespondToReview(10, 7, reviews.VendorResponseInput{Body: "Hello"})
+
+		assert.ErrorIs(t, err, reviews.ErrResponseExists)
+		mockRepo.AssertNotCalled(t, "CreateVendorResponse", mock.Anything)
+	})
+
+	t.Run("hides unapproved reviews", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		review := approvedReview()
+		review.ModerationStatus = "pending"
+		mockRepo.On("GetReviewByID", uint(10)).Return(review, nil)
+
+		service := reviews.NewService(mockRepo)
+		_, err := service.RespondToReview(10, 7, reviews.VendorResponseInput{Body: "Hello"})
+
+		assert.ErrorIs(t, err, reviews.ErrReviewNotFound)
+	})
+
+	t.Run("validates the body", func(t *testing.T) {
+		service := reviews.NewService(new(MockRepository))
+
+		_, err := service.RespondToReview(10, 7, reviews.VendorResponseInput{Body: "   "})
+		assert.ErrorIs(t, err, reviews.ErrResponseRequired)
+
+		_, err = service.RespondToReview(10, 7, reviews.VendorResponseInput{Body: strings.Repeat("a", 1001)})
+		assert.ErrorIs(t, err, reviews.ErrResponseTooLong)
+	})
+}
+
+func TestServiceListReviewsVendorResponse(t *testing.T) {
+	t.Run("includes only approved vendor responses", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		tool := &domain.Tool{ID: 1, Slug: "chatgpt"}
+		listed := []domain.Review{
+			{ID: 1, ToolID: 1, RatingOverall: 2, VendorResponse: &domain.VendorResponse{
+				ID: 5, ReviewID: 1, UserID: 7, Body: "We are on it", ModerationStatus: "approved",
+				User: domain.User{ID: 7, DisplayName: "Acme Support"},
+			}},
+			{ID: 2, ToolID: 1, RatingOverall: 3, VendorResponse: &domain.VendorResponse{
+				ID: 6, ReviewID: 2, UserID: 7, Body: "Pending reply", ModerationStatus: "pending",
+			}},
+		}
+		mockRepo.On("GetToolBySlug", "chatgpt").Return(tool, nil)
+		mockRepo.On("ListReviewsByTool", uint(1), reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "").Return(listed, int64(2), "", nil)
+
+		service := reviews.NewService(mockRepo)
+		result, _, _, err := service.ListReviews("chatgpt", reviews.ReviewFilters{Sort: reviews.SortNewest}, 1, 10, "", 0)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result[0].VendorResponse)
+		assert.Equal(t, "We are on it", result[0].VendorResponse.Body)
+		assert.Equal(t, "Acme Support", result[0].VendorResponse.User.DisplayName)
+		assert.Empty(t, result[0].VendorResponse.ModerationStatus)
+		assert.Nil(t, result[1].VendorResponse)
+	})
+}
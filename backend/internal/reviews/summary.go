@@ -0,0 +1,66 @@
+package reviews
+
+import (
+	"math"
+
+	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+)
+
+// DimensionAverages holds per-dimension rating averages; nil means no reviewer rated that dimension
+type DimensionAverages struct {
+	EaseOfUse *float64 `json:"ease_of_use"`
+	Value     *float64 `json:"value"`
+	Accuracy  *float64 `json:"accuracy"`
+	Speed     *float64 `json:"speed"`
+	Support   *float64 `json:"support"`
+}
+
+// RatingBucket is one bar of the star histogram
+type RatingBucket struct {
+	Rating  int     `json:"rating"`
+	Count   int     `json:"count"`
+	Percent float64 `json:"percent"`
+}
+
+// ReviewSummary is the precomputed rating breakdown for a tool
+type ReviewSummary struct {
+	ToolID           uint              `json:"tool_id"`
+	ReviewCount      int               `json:"review_count"`
+	AvgRatingOverall float64           `json:"avg_rating_overall"`
+	Dimensions       DimensionAverages `json:"dimensions"`
+	Distribution     []RatingBucket    `json:"distribution"`
+}
+
+// newReviewSummary builds the summary from the aggregates stored on the tool.
+// The distribution is ordered from 5 stars down to 1 star.
+func newReviewSummary(tool *domain.Tool) *ReviewSummary {
+	counts := []int{tool.Rating5Count, tool.Rating4Count, tool.Rating3Count, tool.Rating2Count, tool.Rating1Count}
+
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+
+	distribution := make([]RatingBucket, 0, len(counts))
+	for i, count := range counts {
+		bucket := RatingBucket{Rating: 5 - i, Count: count}
+		if total > 0 {
+			bucket.Percent = math.Round(float64(count)*1000/float64(total)) / 10
+		}
+		distribution = append(distribution, bucket)
+	}
+
+	return &ReviewSummary{
+		ToolID:           tool.ID,
+		ReviewCount:      tool.ReviewCount,
+		AvgRatingOverall: tool.AvgRatingOverall,
+		Dimensions: DimensionAverages{
+			EaseOfUse: tool.AvgEaseOfUse,
+			Value:     tool.AvgValue,
+			Accuracy:  tool.AvgAccuracy,
+			Speed:     tool.AvgSpeed,
+			Support:   tool.AvgSupport,
+		},
+		Distribution: distribution,
+	}
+}
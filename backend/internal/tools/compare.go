@@ -0,0 +1,200 @@
+package tools
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Comparison limits
+const (
+	MinCompareTools = 2
+	MaxCompareTools = 4
+)
+
+// ReviewAggregate holds review averages for a single tool
+type ReviewAggregate struct {
+	ToolID       uint
+	AvgOverall   *float64
+	AvgEaseOfUse *float64
+	AvgValue     *float64
+	AvgAccuracy  *float64
+	AvgSpeed     *float64
+	AvgSupport   *float64
+	ReviewCount  int64
+}
+
+// ComparedTool is the identifying header for a tool in a comparison
+type ComparedTool struct {
+	ID          uint   `json:"id"`
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	LogoURL     string `json:"logo_url,omitempty"`
+	Tagline     string `json:"tagline,omitempty"`
+	OfficialURL string `json:"official_url,omitempty"`
+}
+
+// ComparisonRow is a single attribute compared across tools.
+// Values are ordered the same way as ComparisonResult.Tools.
+type ComparisonRow struct {
+	Key     string        `json:"key"`
+	Label   string        `json:"label"`
+	Values  []interface{} `json:"values"`
+	Differs bool          `json:"differs"`
+}
+
+// ComparisonResult is the normalized side-by-side comparison payload
+type ComparisonResult struct {
+	Tools []ComparedTool  `json:"tools"`
+	Rows  []ComparisonRow `json:"rows"`
+}
+
+// ParseCompareSlugs splits a comma-separated slug list, trimming blanks and duplicates
+func ParseCompareSlugs(raw string) []string {
+	seen := make(map[string]bool)
+	slugs := []string{}
+	for _, part := range strings.Split(raw, ",") {
+		slug := strings.TrimSpace(part)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		slugs = append(slugs, slug)
+	}
+	return slugs
+}
+
+// buildComparison assembles the comparison rows for the given tools
+func buildComparison(toolList []Tool, aggregates map[uint]ReviewAggregate) *ComparisonResult {
+	result := &ComparisonResult{
+		Tools: make([]ComparedTool, len(toolList)),
+	}
+
+	for i, t := range toolList {
+		result.Tools[i] = ComparedTool{
+			ID:          t.ID,
+			Slug:        t.Slug,
+			Name:        t.Name,
+			LogoURL:     t.LogoURL,
+			Tagline:     t.Tagline,
+			OfficialURL: t.OfficialURL,
+		}
+	}
+
+	addRow := func(key, label string, value func(t Tool, agg ReviewAggregate) interface{}) {
+		row := ComparisonRow{Key: key, Label: label, Values: make([]interface{}, len(toolList))}
+		for i, t := range toolList {
+			row.Values[i] = value(t, aggregates[t.ID])
+		}
+		row.Differs = valuesDiffer(row.Values)
+		result.Rows = append(result.Rows, row)
+	}
+
+	addRow("pricing_summary", "Pricing", func(t Tool, _ ReviewAggregate) interface{} {
+		return strings.TrimSpace(t.PricingSummary)
+	})
+	addRow("has_free_tier", "Free tier", func(t Tool, _ ReviewAggregate) interface{} {
+		return t.HasFreeTier
+	})
+	addRow("platforms", "Platforms", func(t Tool, _ ReviewAggregate) interface{} {
+		return splitList(t.Platforms)
+	})
+	addRow("target_roles", "Target roles", func(t Tool, _ ReviewAggregate) interface{} {
+		return splitList(t.TargetRoles)
+	})
+	addRow("badges", "Badges", func(t Tool, _ ReviewAggregate) interface{} {
+		names := make([]string, len(t.Badges))
+		for i, b := range t.Badges {
+			names[i] = b.Name
+		}
+		sort.Strings(names)
+		return names
+	})
+	addRow("tags", "Tags", func(t Tool, _ ReviewAggregate) interface{} {
+		names := make([]string, len(t.Tags))
+		for i, tag := range t.Tags {
+			names[i] = tag.Name
+		}
+		sort.Strings(names)
+		return names
+	})
+	addRow("avg_rating_overall", "Overall rating", func(_ Tool, agg ReviewAggregate) interface{} {
+		return roundRating(agg.AvgOverall)
+	})
+	addRow("avg_rating_ease_of_use", "Ease of use", func(_ Tool, agg ReviewAggregate) interface{} {
+		return roundRating(agg.AvgEaseOfUse)
+	})
+	addRow("avg_rating_value", "Value", func(_ Tool, agg ReviewAggregate) interface{} {
+		return roundRating(agg.AvgValue)
+	})
+	addRow("avg_rating_accuracy", "Accuracy", func(_ Tool, agg ReviewAggregate) interface{} {
+		return roundRating(agg.AvgAccuracy)
+	})
+	addRow("avg_rating_speed", "Speed", func(_ Tool, agg ReviewAggregate) interface{} {
+		return roundRating(agg.AvgSpeed)
+	})
+	addRow("avg_rating_support", "Support", func(_ Tool, agg ReviewAggregate) interface{} {
+		return roundRating(agg.AvgSupport)
+	})
+	addRow("review_count", "Reviews", func(_ Tool, agg ReviewAggregate) interface{} {
+		return agg.ReviewCount
+	})
+
+	return result
+}
+
+// splitList turns a comma-separated text field into a sorted list of values
+func splitList(value string) []string {
+	items := []string{}
+	for _, part := range strings.Split(value, ",") {
+		item := strings.TrimSpace(part)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return strings.ToLower(items[i]) < strings.ToLower(items[j])
+	})
+	return items
+}
+
+// roundRating rounds an average to two decimals, keeping nil for "no ratings"
+func roundRating(avg *float64) *float64 {
+	if avg == nil {
+		return nil
+	}
+	rounded := math.Round(*avg*100) / 100
+	return &rounded
+}
+
+// valuesDiffer reports whether any value in the row differs from the first
+func valuesDiffer(values []interface{}) bool {
+	for i := 1; i < len(values); i++ {
+		if !reflect.DeepEqual(normalizeValue(values[0]), normalizeValue(values[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeValue makes values comparable regardless of case or pointer identity
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return strings.ToLower(v)
+	case []string:
+		lowered := make([]string, len(v))
+		for i, s := range v {
+			lowered[i] = strings.ToLower(s)
+		}
+		return lowered
+	case *float64:
+		if v == nil {
+			return nil
+		}
+		return *v
+	default:
+		return v
+	}
+}
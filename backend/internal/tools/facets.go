@@ -0,0 +1,33 @@
+package tools
+
+// FacetCount is the number of tools matching a single filter option
+type FacetCount struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int64  `json:"count"`
+}
+
+// Facets holds per-option counts for the filter sidebar.
+// Each dimension is counted with every active filter applied except its own,
+// so sibling options stay selectable.
+type Facets struct {
+	Categories []FacetCount `json:"categories"`
+	Price      []FacetCount `json:"price"`
+	Platforms  []FacetCount `json:"platforms"`
+	Tags       []FacetCount `json:"tags"`
+	Ratings    []FacetCount `json:"ratings"`
+}
+
+// RatingBands are the min_rating thresholds reported in the ratings facet
+var RatingBands = []float64{4.5, 4, 3, 2}
+
+// nonZero drops options with no matching tools
+func nonZero(counts []FacetCount) []FacetCount {
+	result := make([]FacetCount, 0, len(counts))
+	for _, c := range counts {
+		if c.Count > 0 {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -1,12 +1,20 @@
 package tools
 
-// ToolFilters defines the available filters for tool queries
+import "time"
+
+// ToolFilters defines the available filters for tool queries.
+// Multi-value filters match a tool if any of their values match, except Tags with TagMatch "all".
 type ToolFilters struct {
-	Category  string  // Category slug to filter by
-	Price     string  // free, freemium, paid
-	MinRating float64 // Minimum average rating
-	Platform  string  // web, mobile, api (searches in platforms text field)
-	Sort      string  // top_rated, most_bookmarked, trending, newest
+	Categories   []string  // Category slugs to filter by
+	Prices       []string  // free, freemium, paid
+	MinRating    float64   // Minimum average rating
+	Platforms    []string  // web, mobile, api (searches in platforms text field)
+	Tags         []string  // Tag slugs to filter by
+	TagMatch     string    // any (default) or all
+	Badges       []string  // Badge slugs to filter by
+	TargetRole   string    // Searches in target_roles text field
+	CreatedAfter time.Time // Only tools added on or after this time; zero means no limit
+	Sort         string    // top_rated, most_bookmarked, trending, newest, relevance
 }
 
 // SortOptions defines valid sort options
@@ -15,12 +23,19 @@ const (
 	SortMostBookmarked = "most_bookmarked"
 	SortTrending       = "trending"
 	SortNewest         = "newest"
+	SortRelevance      = "relevance" // Search only; falls back to top_rated without a query
+)
+
+// TagMatch options
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
 )
 
 // ValidateSort returns a valid sort option, defaulting to top_rated
 func ValidateSort(sort string) string {
 	switch sort {
-	case SortTopRated, SortMostBookmarked, SortTrending, SortNewest:
+	case SortTopRated, SortMostBookmarked, SortTrending, SortNewest, SortRelevance:
 		return sort
 	default:
 		return SortTopRated
@@ -36,3 +51,26 @@ func ValidatePrice(price string) string {
 		return ""
 	}
 }
+
+// ValidatePrices drops invalid and duplicate price filters, returning nil if none remain
+func ValidatePrices(prices []string) []string {
+	var valid []string
+	seen := make(map[string]bool)
+	for _, price := range prices {
+		price = ValidatePrice(price)
+		if price == "" || seen[price] {
+			continue
+		}
+		seen[price] = true
+		valid = append(valid, price)
+	}
+	return valid
+}
+
+// ValidateTagMatch returns a valid tag match mode, defaulting to any
+func ValidateTagMatch(match string) string {
+	if match == TagMatchAll {
+		return TagMatchAll
+	}
+	return TagMatchAny
+}
@@ -4,8 +4,12 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/requests"
 	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
 )
 
@@ -31,7 +35,10 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	search := rg.Group("/search")
 	{
 		search.GET("/tools", h.SearchTools)
+		search.GET("/suggest", h.Suggest)
 	}
+
+	rg.GET("/compare", h.CompareTools)
 }
 
 // RegisterAdminRoutes registers admin tool routes
@@ -43,6 +50,9 @@ func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
 		tools.GET("/:id", h.AdminGetTool)
 		tools.PATCH("/:id", h.AdminUpdateTool)
 		tools.DELETE("/:id", h.AdminArchiveTool)
+		tools.GET("/:id/owners", h.AdminListOwners)
+		tools.POST("/:id/owners", h.AdminAddOwner)
+		tools.DELETE("/:id/owners/:user_id", h.AdminRemoveOwner)
 	}
 }
 
@@ -51,17 +61,32 @@ func (h *Handler) ListTools(c *gin.Context) {
 	filters := h.parseFilters(c)
 	page, pageSize := h.parsePagination(c)
 
-	tools, total, err := h.service.ListTools(filters, page, pageSize)
+	tools, total, nextCursor, err := h.service.ListTools(filters, page, pageSize, c.Query("cursor"))
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			responses.Error(c, http.StatusBadRequest, "INVALID_CURSOR", "Invalid or expired cursor", nil)
+			return
+		}
 		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch tools", nil)
 		return
 	}
 
-	responses.List(c, tools, map[string]interface{}{
-		"page":      page,
-		"page_size": pageSize,
-		"total":     total,
-	})
+	meta := map[string]interface{}{
+		"page":        page,
+		"page_size":   pageSize,
+		"total":       total,
+		"next_cursor": pagination.NextCursor(nextCursor),
+	}
+	if wantFacets(c, page) {
+		facets, err := h.service.GetFacets("", filters)
+		if err != nil {
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch filter counts", nil)
+			return
+		}
+		meta["facets"] = facets
+	}
+
+	responses.List(c, tools, meta)
 }
 
 // SearchTools handles GET /api/v1/search/tools
@@ -70,18 +95,61 @@ func (h *Handler) SearchTools(c *gin.Context) {
 	filters := h.parseFilters(c)
 	page, pageSize := h.parsePagination(c)
 
-	tools, total, err := h.service.SearchTools(query, filters, page, pageSize)
+	// Search results are ranked by relevance unless a sort is requested
+	if c.Query("sort") == "" {
+		filters.Sort = SortRelevance
+	}
+
+	tools, total, nextCursor, err := h.service.SearchTools(query, filters, page, pageSize, c.Query("cursor"))
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			responses.Error(c, http.StatusBadRequest, "INVALID_CURSOR", "Invalid or expired cursor", nil)
+			return
+		}
 		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to search tools", nil)
 		return
 	}
 
-	responses.List(c, tools, map[string]interface{}{
-		"page":      page,
-		"page_size": pageSize,
-		"total":     total,
-		"query":     query,
-	})
+	meta := map[string]interface{}{
+		"page":        page,
+		"page_size":   pageSize,
+		"total":       total,
+		"next_cursor": pagination.NextCursor(nextCursor),
+		"query":       query,
+	}
+	if wantFacets(c, page) {
+		facets, err := h.service.GetFacets(query, filters)
+		if err != nil {
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch filter counts", nil)
+			return
+		}
+		meta["facets"] = facets
+	}
+
+	responses.List(c, tools, meta)
+}
+
+// wantFacets reports whether a listing should include filter counts. They are only computed
+// for the first page, since they don't change while paging; ?facets=true or false overrides this.
+func wantFacets(c *gin.Context, page int) bool {
+	if requested, err := strconv.ParseBool(c.Query("facets")); err == nil {
+		return requested
+	}
+	return c.Query("cursor") == "" && page <= 1
+}
+
+// Suggest handles GET /api/v1/search/suggest
+func (h *Handler) Suggest(c *gin.Context) {
+	query := c.Query("q")
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	suggestions, err := h.service.Suggest(query, limit)
+	if err != nil {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch suggestions", nil)
+		return
+	}
+
+	responses.Success(c, suggestions)
 }
 
 // GetTool handles GET /api/v1/tools/:slug
@@ -121,17 +189,55 @@ func (h *Handler) GetToolAlternatives(c *gin.Context) {
 	})
 }
 
-// parseFilters extracts filter parameters from the request
+// CompareTools handles GET /api/v1/compare?tools=slug1,slug2
+func (h *Handler) CompareTools(c *gin.Context) {
+	slugs := ParseCompareSlugs(c.Query("tools"))
+
+	result, err := h.service.CompareTools(slugs)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrCompareCount):
+			responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Provide between 2 and 4 distinct tool slugs", map[string]string{"tools": "invalid_count"})
+		case errors.Is(err, ErrToolNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to compare tools", nil)
+		}
+		return
+	}
+
+	responses.Success(c, result)
+}
+
+// parseFilters extracts filter parameters from the request.
+// Multi-value filters accept comma-separated lists or repeated parameters.
 func (h *Handler) parseFilters(c *gin.Context) ToolFilters {
 	minRating, _ := strconv.ParseFloat(c.Query("min_rating"), 64)
 
 	return ToolFilters{
-		Category:  c.Query("category"),
-		Price:     c.Query("price"),
-		MinRating: minRating,
-		Platform:  c.Query("platform"),
-		Sort:      c.DefaultQuery("sort", SortTopRated),
+		Categories:   requests.ListParam(c, "category"),
+		Prices:       requests.ListParam(c, "price"),
+		MinRating:    minRating,
+		Platforms:    requests.ListParam(c, "platform"),
+		Tags:         requests.ListParam(c, "tags"),
+		TagMatch:     ValidateTagMatch(c.Query("tag_match")),
+		Badges:       requests.ListParam(c, "badges"),
+		TargetRole:   strings.TrimSpace(c.Query("target_role")),
+		CreatedAfter: parseDateParam(c.Query("created_after")),
+		Sort:         c.DefaultQuery("sort", SortTopRated),
+	}
+}
+
+// parseDateParam parses a YYYY-MM-DD or RFC 3339 timestamp, returning the zero time if invalid
+func parseDateParam(raw string) time.Time {
+	raw = strings.TrimSpace(raw)
+	if t, err := time.Parse("2006-01-02", raw); err == nil {
+		return t
 	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t
+	}
+	return time.Time{}
 }
 
 // parsePagination extracts pagination parameters from the request
@@ -264,3 +370,89 @@ func (h *Handler) AdminArchiveTool(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// AdminListOwners handles GET /api/v1/admin/tools/:id/owners
+func (h *Handler) AdminListOwners(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_ID", "Invalid tool ID", nil)
+		return
+	}
+
+	owners, err := h.service.ListToolOwners(uint(id))
+	if err != nil {
+		if errors.Is(err, ErrToolNotFound) {
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
+			return
+		}
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fetch tool owners", nil)
+		return
+	}
+
+	responses.Success(c, owners)
+}
+
+// AdminAddOwner handles POST /api/v1/admin/tools/:id/owners
+func (h *Handler) AdminAddOwner(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_ID", "Invalid tool ID", nil)
+		return
+	}
+
+	var input AddOwnerInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", nil)
+		return
+	}
+
+	// The admin granting ownership is recorded as the verifier
+	adminID, _ := c.Get("user_id")
+	verifiedBy, _ := adminID.(uint)
+
+	owner, err := h.service.AddToolOwner(uint(id), input.UserID, verifiedBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrToolNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
+		case errors.Is(err, ErrUserNotFound):
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "User not found", nil)
+		case errors.Is(err, ErrOwnerExists):
+			responses.Error(c, http.StatusConflict, "ALREADY_OWNER", "User is already an owner of this tool", nil)
+		default:
+			responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to add tool owner", nil)
+		}
+		return
+	}
+
+	responses.Created(c, owner)
+}
+
+// AdminRemoveOwner handles DELETE /api/v1/admin/tools/:id/owners/:user_id
+func (h *Handler) AdminRemoveOwner(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_ID", "Invalid tool ID", nil)
+		return
+	}
+
+	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 32)
+	if err != nil {
+		responses.Error(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	if err := h.service.RemoveToolOwner(uint(id), uint(userID)); err != nil {
+		if errors.Is(err, ErrOwnerNotFound) {
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "User is not an owner of this tool", nil)
+			return
+		}
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to remove tool owner", nil)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
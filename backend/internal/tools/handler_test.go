@@ -5,11 +5,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
 	"github.com/your-org/ai-tools-atlas-backend/internal/tools"
 )
 
@@ -18,20 +20,36 @@ type MockService struct {
 	mock.Mock
 }
 
-func (m *MockService) ListTools(filters tools.ToolFilters, page, pageSize int) ([]domain.Tool, int64, error) {
-	args := m.Called(filters, page, pageSize)
+func (m *MockService) ListTools(filters tools.ToolFilters, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error) {
+	args := m.Called(filters, page, pageSize, cursor)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, 0, "", args.Error(3)
 	}
-	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.String(2), args.Error(3)
 }
 
-func (m *MockService) SearchTools(query string, filters tools.ToolFilters, page, pageSize int) ([]domain.Tool, int64, error) {
-	args := m.Called(query, filters, page, pageSize)
+func (m *MockService) SearchTools(query string, filters tools.ToolFilters, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error) {
+	args := m.Called(query, filters, page, pageSize, cursor)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, 0, "", args.Error(3)
 	}
-	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.String(2), args.Error(3)
+}
+
+func (m *MockService) Suggest(query string, limit int) ([]tools.Suggestion, error) {
+	args := m.Called(query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]tools.Suggestion), args.Error(1)
+}
+
+func (m *MockService) GetFacets(query string, filters tools.ToolFilters) (*tools.Facets, error) {
+	args := m.Called(query, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tools.Facets), args.Error(1)
 }
 
 func (m *MockService) GetToolBySlug(slug string) (*domain.Tool, error) {
@@ -58,6 +76,14 @@ func (m *MockService) GetToolAlternatives(slug string) (*tools.AlternativesResul
 	return args.Get(0).(*tools.AlternativesResult), args.Error(1)
 }
 
+func (m *MockService) CompareTools(slugs []string) (*tools.ComparisonResult, error) {
+	args := m.Called(slugs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tools.ComparisonResult), args.Error(1)
+}
+
 // Admin methods
 func (m *MockService) ListToolsAdmin(search string, includeArchived bool, page, pageSize int) ([]domain.Tool, int64, error) {
 	args := m.Called(search, includeArchived, page, pageSize)
@@ -96,6 +122,27 @@ func (m *MockService) ArchiveTool(id uint) error {
 	return args.Error(0)
 }
 
+func (m *MockService) ListToolOwners(toolID uint) ([]tools.ToolOwner, error) {
+	args := m.Called(toolID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]tools.ToolOwner), args.Error(1)
+}
+
+func (m *MockService) AddToolOwner(toolID, userID, verifiedBy uint) (*tools.ToolOwner, error) {
+	args := m.Called(toolID, userID, verifiedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tools.ToolOwner), args.Error(1)
+}
+
+func (m *MockService) RemoveToolOwner(toolID, userID uint) error {
+	args := m.Called(toolID, userID)
+	return args.Error(0)
+}
+
 func setupTestRouter(service tools.Service) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
@@ -113,8 +160,9 @@ func TestListTools(t *testing.T) {
 			{ID: 2, Slug: "claude", Name: "Claude"},
 		}
 
-		filters := tools.ToolFilters{Sort: tools.SortTopRated}
-		mockService.On("ListTools", filters, 1, 20).Return(expectedTools, int64(2), nil)
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortTopRated}
+		mockService.On("ListTools", filters, 1, 20, "").Return(expectedTools, int64(2), "", nil)
+		mockService.On("GetFacets", "", filters).Return(&tools.Facets{}, nil)
 
 		router := setupTestRouter(mockService)
 		w := httptest.NewRecorder()
@@ -136,13 +184,14 @@ func TestListTools(t *testing.T) {
 		mockService := new(MockService)
 
 		filters := tools.ToolFilters{
-			Category:  "ai-writing",
-			Price:     "free",
-			MinRating: 4.0,
-			Platform:  "web",
-			Sort:      tools.SortNewest,
+			Categories: []string{"ai-writing"},
+			Prices:     []string{"free"},
+			MinRating:  4.0,
+			Platforms:  []string{"web"},
+			TagMatch:   tools.TagMatchAny,
+			Sort:       tools.SortNewest,
 		}
-		mockService.On("ListTools", filters, 2, 10).Return([]domain.Tool{}, int64(0), nil)
+		mockService.On("ListTools", filters, 2, 10, "").Return([]domain.Tool{}, int64(0), "", nil)
 
 		router := setupTestRouter(mockService)
 		w := httptest.NewRecorder()
@@ -154,6 +203,198 @@ func TestListTools(t *testing.T) {
 	})
 }
 
+func TestListToolsMultiValueFilters(t *testing.T) {
+	t.Run("parses comma-separated and repeated filter values", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{
+			Categories:   []string{"ai-writing", "ai-coding"},
+			Prices:       []string{"free", "freemium"},
+			Platforms:    []string{"web", "api"},
+			Tags:         []string{"llm", "open-source"},
+			TagMatch:     tools.TagMatchAll,
+			Badges:       []string{"editors-pick"},
+			TargetRole:   "developer",
+			CreatedAfter: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			Sort:         tools.SortTopRated,
+		}
+		mockService.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+		mockService.On("GetFacets", "", filters).Return(&tools.Facets{}, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools?category=ai-writing,ai-coding&price=free&price=freemium&platform=web,%20api"+
+			"&tags=llm,open-source&tag_match=all&badges=editors-pick&target_role=developer&created_after=2024-01-15", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("defaults tag match and ignores invalid dates", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{
+			Tags:     []string{"llm"},
+			TagMatch: tools.TagMatchAny,
+			Sort:     tools.SortTopRated,
+		}
+		mockService.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+		mockService.On("GetFacets", "", filters).Return(&tools.Facets{}, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools?tags=llm&tag_match=bogus&created_after=yesterday", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestListToolsFacets(t *testing.T) {
+	t.Run("includes facets in meta", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{Categories: []string{"writing"}, TagMatch: tools.TagMatchAny, Sort: tools.SortTopRated}
+		facets := &tools.Facets{
+			Categories: []tools.FacetCount{{Value: "writing", Label: "Writing", Count: 42}},
+			Price:      []tools.FacetCount{{Value: "freemium", Label: "Freemium", Count: 30}},
+		}
+		mockService.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(42), "", nil)
+		mockService.On("GetFacets", "", filters).Return(facets, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools?category=writing", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta := response["meta"].(map[string]interface{})
+		facetMeta := meta["facets"].(map[string]interface{})
+		categoryFacets := facetMeta["categories"].([]interface{})
+		assert.Len(t, categoryFacets, 1)
+		assert.Equal(t, float64(42), categoryFacets[0].(map[string]interface{})["count"])
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("computes facets on later pages when requested", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortTopRated}
+		mockService.On("ListTools", filters, 1, 20, "abc").Return([]domain.Tool{}, int64(42), "", nil)
+		mockService.On("GetFacets", "", filters).Return(&tools.Facets{}, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools?cursor=abc&facets=true", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("skips facets when disabled", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortTopRated}
+		mockService.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools?facets=false", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertNotCalled(t, "GetFacets", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns 500 when facets fail", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortTopRated}
+		mockService.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+		mockService.On("GetFacets", "", filters).Return(nil, assert.AnError)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestListToolsCursor(t *testing.T) {
+	t.Run("passes cursor through and returns next_cursor", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortTrending}
+		mockService.On("ListTools", filters, 1, 20, "abc").Return([]domain.Tool{{ID: 3}}, int64(50), "def", nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools?sort=trending&cursor=abc", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta := response["meta"].(map[string]interface{})
+		assert.Equal(t, "def", meta["next_cursor"])
+		// Facets are only computed for the first page
+		assert.NotContains(t, meta, "facets")
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns null next_cursor on the last page", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortTopRated}
+		mockService.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+		mockService.On("GetFacets", "", filters).Return(&tools.Facets{}, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/tools", nil)
+		router.ServeHTTP(w, req)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta := response["meta"].(map[string]interface{})
+		assert.Contains(t, meta, "next_cursor")
+		assert.Nil(t, meta["next_cursor"])
+	})
+
+	t.Run("returns 400 for invalid cursor", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortRelevance}
+		mockService.On("SearchTools", "chat", filters, 1, 20, "bogus").Return(nil, int64(0), "", pagination.ErrInvalidCursor)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/search/tools?q=chat&cursor=bogus", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
 func TestSearchTools(t *testing.T) {
 	t.Run("searches tools with query", func(t *testing.T) {
 		mockService := new(MockService)
@@ -161,8 +402,9 @@ func TestSearchTools(t *testing.T) {
 			{ID: 1, Slug: "chatgpt", Name: "ChatGPT"},
 		}
 
-		filters := tools.ToolFilters{Sort: tools.SortTopRated}
-		mockService.On("SearchTools", "chat", filters, 1, 20).Return(expectedTools, int64(1), nil)
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortRelevance}
+		mockService.On("SearchTools", "chat", filters, 1, 20, "").Return(expectedTools, int64(1), "", nil)
+		mockService.On("GetFacets", "chat", filters).Return(&tools.Facets{}, nil)
 
 		router := setupTestRouter(mockService)
 		w := httptest.NewRecorder()
@@ -185,8 +427,9 @@ func TestSearchTools(t *testing.T) {
 	t.Run("returns all tools with empty query", func(t *testing.T) {
 		mockService := new(MockService)
 
-		filters := tools.ToolFilters{Sort: tools.SortTopRated}
-		mockService.On("SearchTools", "", filters, 1, 20).Return([]domain.Tool{}, int64(0), nil)
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortRelevance}
+		mockService.On("SearchTools", "", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+		mockService.On("GetFacets", "", filters).Return(&tools.Facets{}, nil)
 
 		router := setupTestRouter(mockService)
 		w := httptest.NewRecorder()
@@ -196,6 +439,47 @@ func TestSearchTools(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("keeps explicit sort over relevance", func(t *testing.T) {
+		mockService := new(MockService)
+
+		filters := tools.ToolFilters{TagMatch: tools.TagMatchAny, Sort: tools.SortNewest}
+		mockService.On("SearchTools", "chat", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+		mockService.On("GetFacets", "chat", filters).Return(&tools.Facets{}, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/search/tools?q=chat&sort=newest", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestSuggest(t *testing.T) {
+	t.Run("returns suggestions for query", func(t *testing.T) {
+		mockService := new(MockService)
+		expected := []tools.Suggestion{
+			{Type: "tool", Slug: "chatgpt", Name: "ChatGPT", Score: 0.6},
+			{Type: "tag", Slug: "chatbots", Name: "Chatbots", Score: 0.5},
+		}
+		mockService.On("Suggest", "chatgtp", 10).Return(expected, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/search/suggest?q=chatgtp", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response["data"], 2)
+
+		mockService.AssertExpectations(t)
+	})
 }
 
 func TestGetTool(t *testing.T) {
@@ -232,3 +516,47 @@ func TestGetTool(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestCompareTools(t *testing.T) {
+	t.Run("returns comparison for parsed slugs", func(t *testing.T) {
+		mockService := new(MockService)
+		expected := &tools.ComparisonResult{
+			Tools: []tools.ComparedTool{{ID: 1, Slug: "chatgpt"}, {ID: 2, Slug: "claude"}},
+		}
+		mockService.On("CompareTools", []string{"chatgpt", "claude"}).Return(expected, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/compare?tools=chatgpt,%20claude,chatgpt", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 400 for invalid tool count", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("CompareTools", []string{"chatgpt"}).Return(nil, tools.ErrCompareCount)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/compare?tools=chatgpt", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 404 when a tool is missing", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("CompareTools", []string{"chatgpt", "missing"}).Return(nil, tools.ErrToolNotFound)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/compare?tools=chatgpt,missing", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -16,3 +16,6 @@ type ToolBadge = domain.ToolBadge
 
 // ToolAlternative is an alias for domain.ToolAlternative
 type ToolAlternative = domain.ToolAlternative
+
+// ToolOwner is an alias for domain.ToolOwner
+type ToolOwner = domain.ToolOwner
@@ -1,12 +1,22 @@
 package tools
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/your-org/ai-tools-atlas-backend/internal/domain"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/pagination"
 	"gorm.io/gorm"
 )
 
+// Suggestion is a typeahead match for a tool, category or tag
+type Suggestion struct {
+	Type  string  `json:"type"`
+	Slug  string  `json:"slug"`
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
 // AlternativesResult holds similar and alternative tools
 type AlternativesResult struct {
 	Similar      []Tool
@@ -15,11 +25,17 @@ type AlternativesResult struct {
 
 // Repository defines the interface for tool data operations
 type Repository interface {
-	ListTools(filters ToolFilters, page, pageSize int) ([]Tool, int64, error)
-	SearchTools(query string, filters ToolFilters, page, pageSize int) ([]Tool, int64, error)
+	ListTools(filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error)
+	SearchTools(query string, filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error)
+	FuzzySearchTools(query string, filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error)
+	Suggest(query string, limit int) ([]Suggestion, error)
 	GetToolBySlug(slug string) (*Tool, error)
 	GetToolByID(id uint) (*Tool, error)
 	GetToolAlternatives(toolID uint, limit int) (*AlternativesResult, error)
+	GetFacets(query string, fuzzy bool, filters ToolFilters) (*Facets, error)
+	HasSearchMatches(query string, filters ToolFilters) (bool, error)
+	GetToolsBySlugs(slugs []string) ([]Tool, error)
+	GetReviewAggregates(toolIDs []uint) (map[uint]ReviewAggregate, error)
 	// Admin methods
 	ListToolsAdmin(search string, includeArchived bool, page, pageSize int) ([]Tool, int64, error)
 	GetToolByIDAdmin(id uint) (*Tool, error)
@@ -27,6 +43,12 @@ type Repository interface {
 	Update(tool *Tool) error
 	Archive(id uint) error
 	SlugExists(slug string, excludeID uint) (bool, error)
+	// Ownership
+	ListOwners(toolID uint) ([]ToolOwner, error)
+	IsOwner(toolID, userID uint) (bool, error)
+	AddOwner(owner *ToolOwner) error
+	RemoveOwner(toolID, userID uint) (bool, error)
+	UserExists(userID uint) (bool, error)
 }
 
 // repository implements the Repository interface
@@ -39,75 +61,155 @@ func NewRepository(db *gorm.DB) Repository {
 	return &repository{db: db}
 }
 
-// ListTools returns paginated tools with filters
-func (r *repository) ListTools(filters ToolFilters, page, pageSize int) ([]Tool, int64, error) {
-	var tools []Tool
+// ListTools returns paginated tools with filters.
+// When cursor is set, the page starts after the cursor position instead of at the page offset.
+func (r *repository) ListTools(filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error) {
 	var total int64
 
 	query := r.buildBaseQuery(filters)
 
 	// Count total
 	if err := query.Model(&domain.Tool{}).Count(&total).Error; err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
-	// Apply sorting
-	query = r.applySorting(query, filters.Sort)
-
-	// Apply pagination
-	offset := (page - 1) * pageSize
-	query = query.
-		Preload("PrimaryCategory").
-		Preload("Tags").
-		Preload("Badges").
-		Limit(pageSize).
-		Offset(offset)
-
-	if err := query.Find(&tools).Error; err != nil {
-		return nil, 0, err
+	tools, nextCursor, err := r.findPage(query, filters.Sort, toolSortKeys(filters.Sort), page, pageSize, cursor)
+	if err != nil {
+		return nil, 0, "", err
 	}
 
-	return tools, total, nil
+	return tools, total, nextCursor, nil
 }
 
 // SearchTools performs a free-text search with filters
-func (r *repository) SearchTools(searchQuery string, filters ToolFilters, page, pageSize int) ([]Tool, int64, error) {
-	var tools []Tool
+func (r *repository) SearchTools(searchQuery string, filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error) {
 	var total int64
 
 	query := r.buildBaseQuery(filters)
 
-	// Add search conditions if query is not empty
-	if searchQuery != "" {
-		searchTerm := "%" + strings.ToLower(searchQuery) + "%"
-		query = query.Where(
-			"LOWER(tools.name) LIKE ? OR LOWER(tools.tagline) LIKE ? OR LOWER(tools.description) LIKE ? OR LOWER(tools.best_for) LIKE ? OR LOWER(tools.primary_use_cases) LIKE ?",
-			searchTerm, searchTerm, searchTerm, searchTerm, searchTerm,
-		)
+	// Add full-text search condition if query is not empty
+	searchQuery = strings.TrimSpace(searchQuery)
+	query = r.applySearchCondition(query, searchQuery, false)
+
+	// Count total
+	if err := query.Model(&domain.Tool{}).Count(&total).Error; err != nil {
+		return nil, 0, "", err
+	}
+
+	// Rank by relevance when a query is present
+	keys := toolSortKeys(filters.Sort)
+	if filters.Sort == SortRelevance && searchQuery != "" {
+		keys = []pagination.Key{
+			{Expr: "ts_rank(tools.search_vector, websearch_to_tsquery('english', ?))", Vars: []interface{}{searchQuery}, Desc: true, Kind: pagination.KindFloat},
+			{Expr: "tools.bayesian_rating", Desc: true, Kind: pagination.KindFloat},
+		}
+	}
+
+	tools, nextCursor, err := r.findPage(query, filters.Sort, keys, page, pageSize, cursor)
+	if err != nil {
+		return nil, 0, "", err
 	}
 
+	return tools, total, nextCursor, nil
+}
+
+// FuzzySearchTools matches tools by trigram similarity on name and tagline,
+// used when full-text search finds nothing (e.g. misspelled queries)
+func (r *repository) FuzzySearchTools(searchQuery string, filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error) {
+	var total int64
+
+	query := r.applySearchCondition(r.buildBaseQuery(filters), searchQuery, true)
+
 	// Count total
 	if err := query.Model(&domain.Tool{}).Count(&total).Error; err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
-	// Apply sorting
-	query = r.applySorting(query, filters.Sort)
+	// Order by closest match first when ranking by relevance
+	keys := toolSortKeys(filters.Sort)
+	if filters.Sort == SortRelevance {
+		keys = []pagination.Key{
+			{Expr: "GREATEST(similarity(tools.name, ?), word_similarity(?, tools.name))", Vars: []interface{}{searchQuery, searchQuery}, Desc: true, Kind: pagination.KindFloat},
+			{Expr: "tools.bayesian_rating", Desc: true, Kind: pagination.KindFloat},
+		}
+	}
 
-	// Apply pagination
-	offset := (page - 1) * pageSize
-	query = query.
+	tools, nextCursor, err := r.findPage(query, filters.Sort, keys, page, pageSize, cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	return tools, total, nextCursor, nil
+}
+
+// findPage loads one page of tools in key order, using keyset pagination when a cursor is given.
+// It returns the cursor for the following page, or "" when this is the last page.
+func (r *repository) findPage(query *gorm.DB, sort string, keys []pagination.Key, page, pageSize int, rawCursor string) ([]Tool, string, error) {
+	cursor, err := pagination.Decode(rawCursor, sort)
+	if err != nil {
+		return nil, "", err
+	}
+	if cursor != nil {
+		condition, err := pagination.Condition(keys, "tools.id", cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		query = query.Where(condition)
+	} else {
+		query = query.Offset((page - 1) * pageSize)
+	}
+
+	// Fetch one extra row to find out whether another page exists
+	var tools []Tool
+	err = query.
+		Order(pagination.OrderBy(keys, "tools.id")).
 		Preload("PrimaryCategory").
 		Preload("Tags").
 		Preload("Badges").
-		Limit(pageSize).
-		Offset(offset)
+		Limit(pageSize + 1).
+		Find(&tools).Error
+	if err != nil {
+		return nil, "", err
+	}
+	if len(tools) <= pageSize {
+		return tools, "", nil
+	}
 
-	if err := query.Find(&tools).Error; err != nil {
-		return nil, 0, err
+	tools = tools[:pageSize]
+	last := tools[len(tools)-1]
+	values, err := pagination.Values(r.db, "tools", keys, "tools.id", last.ID)
+	if err != nil {
+		return nil, "", err
 	}
 
-	return tools, total, nil
+	return tools, pagination.Encode(sort, values, last.ID), nil
+}
+
+// Suggest returns tools, categories and tags whose names are similar to the query
+func (r *repository) Suggest(searchQuery string, limit int) ([]Suggestion, error) {
+	var suggestions []Suggestion
+	prefix := strings.ToLower(searchQuery) + "%"
+	err := r.db.Raw(`
+		SELECT type, slug, name, score FROM (
+			SELECT 'tool' AS type, slug, name, GREATEST(similarity(name, @q), word_similarity(@q, name)) AS score
+			FROM tools
+			WHERE archived_at IS NULL AND (@q <% name OR LOWER(name) LIKE @prefix)
+			UNION ALL
+			SELECT 'category' AS type, slug, name, GREATEST(similarity(name, @q), word_similarity(@q, name)) AS score
+			FROM categories
+			WHERE @q <% name OR LOWER(name) LIKE @prefix
+			UNION ALL
+			SELECT 'tag' AS type, slug, name, GREATEST(similarity(name, @q), word_similarity(@q, name)) AS score
+			FROM tags
+			WHERE @q <% name OR LOWER(name) LIKE @prefix
+		) AS matches
+		ORDER BY score DESC, name ASC
+		LIMIT @limit
+	`, map[string]interface{}{"q": searchQuery, "prefix": prefix, "limit": limit}).Scan(&suggestions).Error
+	if err != nil {
+		return nil, err
+	}
+	return suggestions, nil
 }
 
 // GetToolBySlug finds a tool by its slug
@@ -147,21 +249,31 @@ func (r *repository) buildBaseQuery(filters ToolFilters) *gorm.DB {
 	query := r.db.Model(&domain.Tool{}).Where("tools.archived_at IS NULL")
 
 	// Filter by category
-	if filters.Category != "" {
+	if len(filters.Categories) > 0 {
 		query = query.
 			Joins("JOIN categories ON categories.id = tools.primary_category_id").
-			Where("categories.slug = ?", filters.Category)
-	}
-
-	// Filter by price
-	if filters.Price != "" {
-		switch filters.Price {
-		case "free":
-			query = query.Where("tools.has_free_tier = ? AND (tools.pricing_summary ILIKE ? OR tools.pricing_summary ILIKE ?)", true, "%free%", "%$0%")
-		case "freemium":
-			query = query.Where("tools.has_free_tier = ?", true)
-		case "paid":
-			query = query.Where("tools.has_free_tier = ?", false)
+			Where("categories.slug IN ?", filters.Categories)
+	}
+
+	// Filter by price (any of the selected tiers)
+	if len(filters.Prices) > 0 {
+		var conditions []string
+		var args []interface{}
+		for _, price := range filters.Prices {
+			switch price {
+			case "free":
+				conditions = append(conditions, "(tools.has_free_tier = ? AND (tools.pricing_summary ILIKE ? OR tools.pricing_summary ILIKE ?))")
+				args = append(args, true, "%free%", "%$0%")
+			case "freemium":
+				conditions = append(conditions, "tools.has_free_tier = ?")
+				args = append(args, true)
+			case "paid":
+				conditions = append(conditions, "tools.has_free_tier = ?")
+				args = append(args, false)
+			}
+		}
+		if len(conditions) > 0 {
+			query = query.Where(strings.Join(conditions, " OR "), args...)
 		}
 	}
 
@@ -170,28 +282,204 @@ func (r *repository) buildBaseQuery(filters ToolFilters) *gorm.DB {
 		query = query.Where("tools.avg_rating_overall >= ?", filters.MinRating)
 	}
 
-	// Filter by platform
-	if filters.Platform != "" {
-		platformTerm := "%" + strings.ToLower(filters.Platform) + "%"
-		query = query.Where("LOWER(tools.platforms) LIKE ?", platformTerm)
+	// Filter by platform (any of the selected platforms)
+	if len(filters.Platforms) > 0 {
+		conditions := make([]string, len(filters.Platforms))
+		args := make([]interface{}, len(filters.Platforms))
+		for i, platform := range filters.Platforms {
+			conditions[i] = "LOWER(tools.platforms) LIKE ?"
+			args[i] = "%" + strings.ToLower(platform) + "%"
+		}
+		query = query.Where(strings.Join(conditions, " OR "), args...)
+	}
+
+	// Filter by tags: any selected tag, or all of them
+	if len(filters.Tags) > 0 {
+		if filters.TagMatch == TagMatchAll {
+			query = query.Where(`tools.id IN (
+				SELECT tool_tags.tool_id FROM tool_tags
+				JOIN tags ON tags.id = tool_tags.tag_id
+				WHERE tags.slug IN ?
+				GROUP BY tool_tags.tool_id
+				HAVING COUNT(DISTINCT tags.slug) = ?)`, filters.Tags, len(filters.Tags))
+		} else {
+			query = query.Where(`tools.id IN (
+				SELECT tool_tags.tool_id FROM tool_tags
+				JOIN tags ON tags.id = tool_tags.tag_id
+				WHERE tags.slug IN ?)`, filters.Tags)
+		}
+	}
+
+	// Filter by badges (any of the selected badges)
+	if len(filters.Badges) > 0 {
+		query = query.Where(`tools.id IN (
+			SELECT tool_badges.tool_id FROM tool_badges
+			JOIN badges ON badges.id = tool_badges.badge_id
+			WHERE badges.slug IN ?)`, filters.Badges)
+	}
+
+	// Filter by target role
+	if filters.TargetRole != "" {
+		roleTerm := "%" + strings.ToLower(filters.TargetRole) + "%"
+		query = query.Where("LOWER(tools.target_roles) LIKE ?", roleTerm)
+	}
+
+	// Filter by creation date
+	if !filters.CreatedAfter.IsZero() {
+		query = query.Where("tools.created_at >= ?", filters.CreatedAfter)
 	}
 
 	return query
 }
 
-// applySorting adds the appropriate ORDER BY clause
-func (r *repository) applySorting(query *gorm.DB, sort string) *gorm.DB {
+// applySearchCondition restricts the query to tools matching the search text,
+// using full-text search or, when fuzzy is set, trigram similarity
+func (r *repository) applySearchCondition(query *gorm.DB, searchQuery string, fuzzy bool) *gorm.DB {
+	if searchQuery == "" {
+		return query
+	}
+	if fuzzy {
+		return query.Where("tools.name % ? OR ? <% tools.name OR tools.tagline % ?", searchQuery, searchQuery, searchQuery)
+	}
+	return query.Where("tools.search_vector @@ websearch_to_tsquery('english', ?)", searchQuery)
+}
+
+// HasSearchMatches reports whether full-text search finds any tool matching the query and filters
+func (r *repository) HasSearchMatches(searchQuery string, filters ToolFilters) (bool, error) {
+	var ids []uint
+	err := r.applySearchCondition(r.buildBaseQuery(filters), strings.TrimSpace(searchQuery), false).
+		Limit(1).
+		Pluck("tools.id", &ids).Error
+	if err != nil {
+		return false, err
+	}
+	return len(ids) > 0, nil
+}
+
+// GetFacets returns per-option counts for every filter dimension.
+// Each dimension is counted against all active filters except its own.
+func (r *repository) GetFacets(searchQuery string, fuzzy bool, filters ToolFilters) (*Facets, error) {
+	facets := &Facets{}
+	scoped := func(f ToolFilters) *gorm.DB {
+		return r.applySearchCondition(r.buildBaseQuery(f), searchQuery, fuzzy)
+	}
+
+	// Categories
+	withoutCategory := filters
+	withoutCategory.Categories = nil
+	err := scoped(withoutCategory).
+		Joins("JOIN categories ON categories.id = tools.primary_category_id").
+		Select("categories.slug AS value, categories.name AS label, COUNT(*) AS count").
+		Group("categories.slug, categories.name").
+		Order("count DESC, categories.name ASC").
+		Scan(&facets.Categories).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Price buckets (mirrors the price filter definitions in buildBaseQuery)
+	withoutPrice := filters
+	withoutPrice.Prices = nil
+	var price struct {
+		Free     int64
+		Freemium int64
+		Paid     int64
+	}
+	err = scoped(withoutPrice).
+		Select(`COUNT(*) FILTER (WHERE tools.has_free_tier = true AND (tools.pricing_summary ILIKE '%free%' OR tools.pricing_summary ILIKE '%$0%')) AS free,
+			COUNT(*) FILTER (WHERE tools.has_free_tier = true) AS freemium,
+			COUNT(*) FILTER (WHERE tools.has_free_tier = false) AS paid`).
+		Scan(&price).Error
+	if err != nil {
+		return nil, err
+	}
+	facets.Price = nonZero([]FacetCount{
+		{Value: "free", Label: "Free", Count: price.Free},
+		{Value: "freemium", Label: "Freemium", Count: price.Freemium},
+		{Value: "paid", Label: "Paid", Count: price.Paid},
+	})
+
+	// Platforms (stored as comma-separated text)
+	withoutPlatform := filters
+	withoutPlatform.Platforms = nil
+	err = scoped(withoutPlatform).
+		Joins("CROSS JOIN LATERAL unnest(string_to_array(tools.platforms, ',')) AS platform(name)").
+		Where("TRIM(platform.name) <> ''").
+		Select("LOWER(TRIM(platform.name)) AS value, COUNT(DISTINCT tools.id) AS count").
+		Group("LOWER(TRIM(platform.name))").
+		Order("count DESC, value ASC").
+		Scan(&facets.Platforms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Tags: with "all" matching, counts narrow to tools that also have every selected tag
+	tagFilters := filters
+	if tagFilters.TagMatch != TagMatchAll {
+		tagFilters.Tags = nil
+	}
+	err = scoped(tagFilters).
+		Joins("JOIN tool_tags ON tool_tags.tool_id = tools.id").
+		Joins("JOIN tags ON tags.id = tool_tags.tag_id").
+		Select("tags.slug AS value, tags.name AS label, COUNT(*) AS count").
+		Group("tags.slug, tags.name").
+		Order("count DESC, tags.name ASC").
+		Scan(&facets.Tags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Rating bands (cumulative "N & up", matching the min_rating filter)
+	withoutRating := filters
+	withoutRating.MinRating = 0
+	var bands []struct {
+		MinRating float64
+		Count     int64
+	}
+	// One placeholder per band: gorm expands slice arguments into a row, not an array
+	bandArgs := make([]interface{}, len(RatingBands))
+	for i, band := range RatingBands {
+		bandArgs[i] = band
+	}
+	bandPlaceholders := strings.TrimSuffix(strings.Repeat("?,", len(RatingBands)), ",")
+	err = scoped(withoutRating).
+		Joins("JOIN unnest(ARRAY["+bandPlaceholders+"]::numeric[]) AS band(min_rating) ON tools.avg_rating_overall >= band.min_rating", bandArgs...).
+		Select("band.min_rating AS min_rating, COUNT(*) AS count").
+		Group("band.min_rating").
+		Scan(&bands).Error
+	if err != nil {
+		return nil, err
+	}
+	bandCounts := make(map[float64]int64, len(bands))
+	for _, b := range bands {
+		bandCounts[b.MinRating] = b.Count
+	}
+	ratings := make([]FacetCount, len(RatingBands))
+	for i, band := range RatingBands {
+		value := strconv.FormatFloat(band, 'f', -1, 64)
+		ratings[i] = FacetCount{Value: value, Label: value + " & up", Count: bandCounts[band]}
+	}
+	facets.Ratings = nonZero(ratings)
+
+	return facets, nil
+}
+
+// toolSortKeys returns the ordering keys for a sort option
+func toolSortKeys(sort string) []pagination.Key {
 	switch sort {
 	case SortMostBookmarked:
-		return query.Order("tools.bookmark_count DESC")
+		return []pagination.Key{{Expr: "tools.bookmark_count", Desc: true, Kind: pagination.KindInt}}
 	case SortTrending:
-		return query.Order("tools.trending_score DESC")
+		return []pagination.Key{{Expr: "tools.trending_score", Desc: true, Kind: pagination.KindFloat}}
 	case SortNewest:
-		return query.Order("tools.created_at DESC")
+		return []pagination.Key{{Expr: "tools.created_at", Desc: true, Kind: pagination.KindTime}}
 	case SortTopRated:
 		fallthrough
 	default:
-		return query.Order("tools.avg_rating_overall DESC, tools.review_count DESC")
+		return []pagination.Key{
+			{Expr: "tools.bayesian_rating", Desc: true, Kind: pagination.KindFloat},
+			{Expr: "tools.review_count", Desc: true, Kind: pagination.KindInt},
+		}
 	}
 }
 
@@ -252,6 +540,47 @@ func (r *repository) GetToolAlternatives(toolID uint, limit int) (*AlternativesR
 	return result, nil
 }
 
+// GetToolsBySlugs returns the non-archived tools matching the given slugs
+func (r *repository) GetToolsBySlugs(slugs []string) ([]Tool, error) {
+	var tools []Tool
+	err := r.db.
+		Preload("PrimaryCategory").
+		Preload("Tags").
+		Preload("Badges").
+		Where("slug IN ? AND archived_at IS NULL", slugs).
+		Find(&tools).Error
+	if err != nil {
+		return nil, err
+	}
+	return tools, nil
+}
+
+// GetReviewAggregates returns overall and per-dimension averages from approved reviews, keyed by tool ID
+func (r *repository) GetReviewAggregates(toolIDs []uint) (map[uint]ReviewAggregate, error) {
+	var rows []ReviewAggregate
+	err := r.db.Table("reviews").
+		Select(`tool_id,
+			AVG(rating_overall) AS avg_overall,
+			AVG(rating_ease_of_use) AS avg_ease_of_use,
+			AVG(rating_value) AS avg_value,
+			AVG(rating_accuracy) AS avg_accuracy,
+			AVG(rating_speed) AS avg_speed,
+			AVG(rating_support) AS avg_support,
+			COUNT(*) AS review_count`).
+		Where("tool_id IN ? AND moderation_status = ?", toolIDs, "approved").
+		Group("tool_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint]ReviewAggregate, len(rows))
+	for _, row := range rows {
+		result[row.ToolID] = row
+	}
+	return result, nil
+}
+
 // ListToolsAdmin returns paginated tools for admin view (optionally including archived)
 func (r *repository) ListToolsAdmin(search string, includeArchived bool, page, pageSize int) ([]Tool, int64, error) {
 	var tools []Tool
@@ -339,3 +668,61 @@ func (r *repository) SlugExists(slug string, excludeID uint) (bool, error) {
 	}
 	return count > 0, nil
 }
+
+// ownerUserColumns limits preloaded owner users to the fields admins need
+func ownerUserColumns(db *gorm.DB) *gorm.DB {
+	return db.Select("id", "email", "display_name")
+}
+
+// ListOwners returns a tool's verified owners with their user info
+func (r *repository) ListOwners(toolID uint) ([]ToolOwner, error) {
+	var owners []ToolOwner
+	err := r.db.
+		Preload("User", ownerUserColumns).
+		Where("tool_id = ?", toolID).
+		Order("created_at ASC").
+		Find(&owners).Error
+	if err != nil {
+		return nil, err
+	}
+	return owners, nil
+}
+
+// IsOwner checks if a user is already an owner of a tool
+func (r *repository) IsOwner(toolID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&ToolOwner{}).
+		Where("tool_id = ? AND user_id = ?", toolID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// AddOwner inserts a tool owner and loads its user info
+func (r *repository) AddOwner(owner *ToolOwner) error {
+	if err := r.db.Create(owner).Error; err != nil {
+		return err
+	}
+	return r.db.Preload("User", ownerUserColumns).First(owner, owner.ID).Error
+}
+
+// RemoveOwner deletes a tool owner, reporting whether one was removed
+func (r *repository) RemoveOwner(toolID, userID uint) (bool, error) {
+	result := r.db.Where("tool_id = ? AND user_id = ?", toolID, userID).Delete(&ToolOwner{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UserExists checks if a user account exists
+func (r *repository) UserExists(userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.User{}).Where("id = ?", userID).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
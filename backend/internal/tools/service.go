@@ -2,6 +2,9 @@ package tools
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"gorm.io/gorm"
 )
@@ -13,8 +16,17 @@ var (
 	ErrSlugExists        = errors.New("slug already exists")
 	ErrCategoryRequired  = errors.New("primary_category_id is required")
 	ErrInvalidCategoryID = errors.New("invalid category ID")
+	ErrCompareCount      = errors.New("comparison requires between 2 and 4 tools")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrOwnerExists       = errors.New("user is already an owner of this tool")
+	ErrOwnerNotFound     = errors.New("user is not an owner of this tool")
 )
 
+// AddOwnerInput represents input for verifying a user as a tool owner
+type AddOwnerInput struct {
+	UserID uint `json:"user_id" binding:"required"`
+}
+
 // CreateToolInput represents input for creating a new tool
 type CreateToolInput struct {
 	Slug              string  `json:"slug"`
@@ -50,17 +62,23 @@ type UpdateToolInput struct {
 
 // Service defines the interface for tool business logic
 type Service interface {
-	ListTools(filters ToolFilters, page, pageSize int) ([]Tool, int64, error)
-	SearchTools(query string, filters ToolFilters, page, pageSize int) ([]Tool, int64, error)
+	ListTools(filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error)
+	SearchTools(query string, filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error)
+	Suggest(query string, limit int) ([]Suggestion, error)
+	GetFacets(query string, filters ToolFilters) (*Facets, error)
 	GetToolBySlug(slug string) (*Tool, error)
 	GetToolByID(id uint) (*Tool, error)
 	GetToolAlternatives(slug string) (*AlternativesResult, error)
+	CompareTools(slugs []string) (*ComparisonResult, error)
 	// Admin methods
 	ListToolsAdmin(search string, includeArchived bool, page, pageSize int) ([]Tool, int64, error)
 	GetToolByIDAdmin(id uint) (*Tool, error)
 	CreateTool(input CreateToolInput) (*Tool, error)
 	UpdateTool(id uint, input UpdateToolInput) (*Tool, error)
 	ArchiveTool(id uint) error
+	ListToolOwners(toolID uint) ([]ToolOwner, error)
+	AddToolOwner(toolID, userID, verifiedBy uint) (*ToolOwner, error)
+	RemoveToolOwner(toolID, userID uint) error
 }
 
 // service implements the Service interface
@@ -73,24 +91,68 @@ func NewService(repo Repository) Service {
 	return &service{repo: repo}
 }
 
-// ListTools returns paginated tools with filters
-func (s *service) ListTools(filters ToolFilters, page, pageSize int) ([]Tool, int64, error) {
+// ListTools returns paginated tools with filters and the cursor for the next page
+func (s *service) ListTools(filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error) {
 	// Validate and set defaults
 	page, pageSize = s.validatePagination(page, pageSize)
 	filters.Sort = ValidateSort(filters.Sort)
-	filters.Price = ValidatePrice(filters.Price)
+	filters.Prices = ValidatePrices(filters.Prices)
 
-	return s.repo.ListTools(filters, page, pageSize)
+	return s.repo.ListTools(filters, page, pageSize, cursor)
 }
 
 // SearchTools performs a free-text search with filters
-func (s *service) SearchTools(query string, filters ToolFilters, page, pageSize int) ([]Tool, int64, error) {
+func (s *service) SearchTools(query string, filters ToolFilters, page, pageSize int, cursor string) ([]Tool, int64, string, error) {
 	// Validate and set defaults
 	page, pageSize = s.validatePagination(page, pageSize)
 	filters.Sort = ValidateSort(filters.Sort)
-	filters.Price = ValidatePrice(filters.Price)
+	filters.Prices = ValidatePrices(filters.Prices)
+
+	tools, total, nextCursor, err := s.repo.SearchTools(query, filters, page, pageSize, cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	// Fall back to fuzzy matching when an exact search finds nothing
+	if total == 0 && strings.TrimSpace(query) != "" {
+		return s.repo.FuzzySearchTools(strings.TrimSpace(query), filters, page, pageSize, cursor)
+	}
+
+	return tools, total, nextCursor, nil
+}
+
+// GetFacets returns filter option counts for a listing or search.
+// Like SearchTools, it falls back to fuzzy matching when the exact query matches nothing.
+func (s *service) GetFacets(query string, filters ToolFilters) (*Facets, error) {
+	filters.Prices = ValidatePrices(filters.Prices)
+	query = strings.TrimSpace(query)
+
+	fuzzy := false
+	if query != "" {
+		exact, err := s.repo.HasSearchMatches(query, filters)
+		if err != nil {
+			return nil, err
+		}
+		fuzzy = !exact
+	}
+
+	return s.repo.GetFacets(query, fuzzy, filters)
+}
+
+// Suggest returns typeahead suggestions for tools, categories and tags
+func (s *service) Suggest(query string, limit int) ([]Suggestion, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < 2 {
+		return []Suggestion{}, nil
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 20 {
+		limit = 20
+	}
 
-	return s.repo.SearchTools(query, filters, page, pageSize)
+	return s.repo.Suggest(query, limit)
 }
 
 // GetToolBySlug finds a tool by its slug
@@ -128,6 +190,42 @@ func (s *service) GetToolAlternatives(slug string) (*AlternativesResult, error)
 	return s.repo.GetToolAlternatives(tool.ID, 6)
 }
 
+// CompareTools builds a side-by-side comparison for 2-4 tool slugs.
+// Tools are returned in the order the slugs were requested.
+func (s *service) CompareTools(slugs []string) (*ComparisonResult, error) {
+	if len(slugs) < MinCompareTools || len(slugs) > MaxCompareTools {
+		return nil, ErrCompareCount
+	}
+
+	found, err := s.repo.GetToolsBySlugs(slugs)
+	if err != nil {
+		return nil, err
+	}
+
+	bySlug := make(map[string]Tool, len(found))
+	for _, t := range found {
+		bySlug[t.Slug] = t
+	}
+
+	ordered := make([]Tool, 0, len(slugs))
+	toolIDs := make([]uint, 0, len(slugs))
+	for _, slug := range slugs {
+		t, ok := bySlug[slug]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrToolNotFound, slug)
+		}
+		ordered = append(ordered, t)
+		toolIDs = append(toolIDs, t.ID)
+	}
+
+	aggregates, err := s.repo.GetReviewAggregates(toolIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildComparison(ordered, aggregates), nil
+}
+
 // validatePagination ensures page and pageSize have valid values
 func (s *service) validatePagination(page, pageSize int) (int, int) {
 	if page < 1 {
@@ -282,3 +380,56 @@ func (s *service) ArchiveTool(id uint) error {
 
 	return s.repo.Archive(id)
 }
+
+// ListToolOwners returns the verified owners of a tool
+func (s *service) ListToolOwners(toolID uint) ([]ToolOwner, error) {
+	if _, err := s.GetToolByIDAdmin(toolID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListOwners(toolID)
+}
+
+// AddToolOwner verifies a user as an owner of a tool, allowing them to respond to its reviews
+func (s *service) AddToolOwner(toolID, userID, verifiedBy uint) (*ToolOwner, error) {
+	if _, err := s.GetToolByIDAdmin(toolID); err != nil {
+		return nil, err
+	}
+
+	userExists, err := s.repo.UserExists(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !userExists {
+		return nil, ErrUserNotFound
+	}
+
+	isOwner, err := s.repo.IsOwner(toolID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if isOwner {
+		return nil, ErrOwnerExists
+	}
+
+	owner := &ToolOwner{
+		ToolID:     toolID,
+		UserID:     userID,
+		VerifiedBy: &verifiedBy,
+	}
+	if err := s.repo.AddOwner(owner); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
+
+// RemoveToolOwner revokes a user's ownership of a tool
+func (s *service) RemoveToolOwner(toolID, userID uint) error {
+	removed, err := s.repo.RemoveOwner(toolID, userID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrOwnerNotFound
+	}
+	return nil
+}
@@ -15,20 +15,36 @@ type MockRepository struct {
 	mock.Mock
 }
 
-func (m *MockRepository) ListTools(filters tools.ToolFilters, page, pageSize int) ([]domain.Tool, int64, error) {
-	args := m.Called(filters, page, pageSize)
+func (m *MockRepository) ListTools(filters tools.ToolFilters, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error) {
+	args := m.Called(filters, page, pageSize, cursor)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, 0, "", args.Error(3)
 	}
-	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.String(2), args.Error(3)
 }
 
-func (m *MockRepository) SearchTools(query string, filters tools.ToolFilters, page, pageSize int) ([]domain.Tool, int64, error) {
-	args := m.Called(query, filters, page, pageSize)
+func (m *MockRepository) SearchTools(query string, filters tools.ToolFilters, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error) {
+	args := m.Called(query, filters, page, pageSize, cursor)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, 0, "", args.Error(3)
 	}
-	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.String(2), args.Error(3)
+}
+
+func (m *MockRepository) FuzzySearchTools(query string, filters tools.ToolFilters, page, pageSize int, cursor string) ([]domain.Tool, int64, string, error) {
+	args := m.Called(query, filters, page, pageSize, cursor)
+	if args.Get(0) == nil {
+		return nil, 0, "", args.Error(3)
+	}
+	return args.Get(0).([]domain.Tool), args.Get(1).(int64), args.String(2), args.Error(3)
+}
+
+func (m *MockRepository) Suggest(query string, limit int) ([]tools.Suggestion, error) {
+	args := m.Called(query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]tools.Suggestion), args.Error(1)
 }
 
 func (m *MockRepository) GetToolBySlug(slug string) (*domain.Tool, error) {
@@ -55,6 +71,35 @@ func (m *MockRepository) GetToolAlternatives(toolID uint, limit int) (*tools.Alt
 	return args.Get(0).(*tools.AlternativesResult), args.Error(1)
 }
 
+func (m *MockRepository) GetFacets(query string, fuzzy bool, filters tools.ToolFilters) (*tools.Facets, error) {
+	args := m.Called(query, fuzzy, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tools.Facets), args.Error(1)
+}
+
+func (m *MockRepository) HasSearchMatches(query string, filters tools.ToolFilters) (bool, error) {
+	args := m.Called(query, filters)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) GetToolsBySlugs(slugs []string) ([]domain.Tool, error) {
+	args := m.Called(slugs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Tool), args.Error(1)
+}
+
+func (m *MockRepository) GetReviewAggregates(toolIDs []uint) (map[uint]tools.ReviewAggregate, error) {
+	args := m.Called(toolIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint]tools.ReviewAggregate), args.Error(1)
+}
+
 // Admin methods
 func (m *MockRepository) ListToolsAdmin(search string, includeArchived bool, page, pageSize int) ([]domain.Tool, int64, error) {
 	args := m.Called(search, includeArchived, page, pageSize)
@@ -92,16 +137,44 @@ func (m *MockRepository) SlugExists(slug string, excludeID uint) (bool, error) {
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockRepository) ListOwners(toolID uint) ([]domain.ToolOwner, error) {
+	args := m.Called(toolID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ToolOwner), args.Error(1)
+}
+
+func (m *MockRepository) IsOwner(toolID, userID uint) (bool, error) {
+	args := m.Called(toolID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) AddOwner(owner *domain.ToolOwner) error {
+	args := m.Called(owner)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RemoveOwner(toolID, userID uint) (bool, error) {
+	args := m.Called(toolID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) UserExists(userID uint) (bool, error) {
+	args := m.Called(userID)
+	return args.Bool(0), args.Error(1)
+}
+
 func TestServiceListTools(t *testing.T) {
 	t.Run("returns tools from repository", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		expectedTools := []domain.Tool{{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}}
 
 		filters := tools.ToolFilters{Sort: tools.SortTopRated}
-		mockRepo.On("ListTools", filters, 1, 20).Return(expectedTools, int64(1), nil)
+		mockRepo.On("ListTools", filters, 1, 20, "").Return(expectedTools, int64(1), "", nil)
 
 		service := tools.NewService(mockRepo)
-		result, total, err := service.ListTools(filters, 1, 20)
+		result, total, _, err := service.ListTools(filters, 1, 20, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedTools, result)
@@ -113,10 +186,10 @@ func TestServiceListTools(t *testing.T) {
 		mockRepo := new(MockRepository)
 
 		filters := tools.ToolFilters{Sort: tools.SortTopRated}
-		mockRepo.On("ListTools", filters, 1, 20).Return([]domain.Tool{}, int64(0), nil)
+		mockRepo.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
 
 		service := tools.NewService(mockRepo)
-		_, _, err := service.ListTools(tools.ToolFilters{}, 0, 0)
+		_, _, _, err := service.ListTools(tools.ToolFilters{}, 0, 0, "")
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -126,10 +199,10 @@ func TestServiceListTools(t *testing.T) {
 		mockRepo := new(MockRepository)
 
 		filters := tools.ToolFilters{Sort: tools.SortTopRated}
-		mockRepo.On("ListTools", filters, 1, 100).Return([]domain.Tool{}, int64(0), nil)
+		mockRepo.On("ListTools", filters, 1, 100, "").Return([]domain.Tool{}, int64(0), "", nil)
 
 		service := tools.NewService(mockRepo)
-		_, _, err := service.ListTools(tools.ToolFilters{}, 1, 200)
+		_, _, _, err := service.ListTools(tools.ToolFilters{}, 1, 200, "")
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -139,10 +212,23 @@ func TestServiceListTools(t *testing.T) {
 		mockRepo := new(MockRepository)
 
 		filters := tools.ToolFilters{Sort: tools.SortTopRated}
-		mockRepo.On("ListTools", filters, 1, 20).Return([]domain.Tool{}, int64(0), nil)
+		mockRepo.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
 
 		service := tools.NewService(mockRepo)
-		_, _, err := service.ListTools(tools.ToolFilters{Sort: "invalid"}, 1, 20)
+		_, _, _, err := service.ListTools(tools.ToolFilters{Sort: "invalid"}, 1, 20, "")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("drops invalid and duplicate price filters", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		filters := tools.ToolFilters{Prices: []string{"free", "paid"}, Sort: tools.SortTopRated}
+		mockRepo.On("ListTools", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+
+		service := tools.NewService(mockRepo)
+		_, _, _, err := service.ListTools(tools.ToolFilters{Prices: []string{"free", "bogus", "paid", "free"}}, 1, 20, "")
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -155,16 +241,131 @@ func TestServiceSearchTools(t *testing.T) {
 		expectedTools := []domain.Tool{{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}}
 
 		filters := tools.ToolFilters{Sort: tools.SortTopRated}
-		mockRepo.On("SearchTools", "chat", filters, 1, 20).Return(expectedTools, int64(1), nil)
+		mockRepo.On("SearchTools", "chat", filters, 1, 20, "").Return(expectedTools, int64(1), "", nil)
 
 		service := tools.NewService(mockRepo)
-		result, total, err := service.SearchTools("chat", filters, 1, 20)
+		result, total, _, err := service.SearchTools("chat", filters, 1, 20, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedTools, result)
 		assert.Equal(t, int64(1), total)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("falls back to fuzzy search when nothing matches", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		expectedTools := []domain.Tool{{ID: 1, Slug: "chatgpt", Name: "ChatGPT"}}
+
+		filters := tools.ToolFilters{Sort: tools.SortRelevance}
+		mockRepo.On("SearchTools", "chatgtp", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+		mockRepo.On("FuzzySearchTools", "chatgtp", filters, 1, 20, "").Return(expectedTools, int64(1), "", nil)
+
+		service := tools.NewService(mockRepo)
+		result, total, _, err := service.SearchTools("chatgtp", filters, 1, 20, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTools, result)
+		assert.Equal(t, int64(1), total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not fall back for empty query", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		filters := tools.ToolFilters{Sort: tools.SortTopRated}
+		mockRepo.On("SearchTools", "", filters, 1, 20, "").Return([]domain.Tool{}, int64(0), "", nil)
+
+		service := tools.NewService(mockRepo)
+		_, total, _, err := service.SearchTools("", filters, 1, 20, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		mockRepo.AssertNotCalled(t, "FuzzySearchTools", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestServiceGetFacets(t *testing.T) {
+	t.Run("returns facets for exact matches", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		facets := &tools.Facets{Categories: []tools.FacetCount{{Value: "chat", Count: 3}}}
+
+		filters := tools.ToolFilters{Prices: []string{"free"}}
+		mockRepo.On("HasSearchMatches", "chat", filters).Return(true, nil)
+		mockRepo.On("GetFacets", "chat", false, filters).Return(facets, nil)
+
+		service := tools.NewService(mockRepo)
+		result, err := service.GetFacets("chat", filters)
+
+		assert.NoError(t, err)
+		assert.Equal(t, facets, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("falls back to fuzzy facets when nothing matches", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		fuzzyFacets := &tools.Facets{Categories: []tools.FacetCount{{Value: "chat", Count: 1}}}
+
+		filters := tools.ToolFilters{}
+		mockRepo.On("HasSearchMatches", "chatgtp", filters).Return(false, nil)
+		mockRepo.On("GetFacets", "chatgtp", true, filters).Return(fuzzyFacets, nil)
+
+		service := tools.NewService(mockRepo)
+		result, err := service.GetFacets("chatgtp", filters)
+
+		assert.NoError(t, err)
+		assert.Equal(t, fuzzyFacets, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("drops invalid price filter", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		mockRepo.On("GetFacets", "", false, tools.ToolFilters{}).Return(&tools.Facets{}, nil)
+
+		service := tools.NewService(mockRepo)
+		_, err := service.GetFacets("", tools.ToolFilters{Prices: []string{"bogus"}})
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "HasSearchMatches", mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestServiceSuggest(t *testing.T) {
+	t.Run("returns suggestions from repository", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		expected := []tools.Suggestion{{Type: "tool", Slug: "midjourney", Name: "Midjourney", Score: 0.8}}
+		mockRepo.On("Suggest", "midjourny", 10).Return(expected, nil)
+
+		service := tools.NewService(mockRepo)
+		result, err := service.Suggest("  midjourny ", 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("skips queries shorter than two characters", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := tools.NewService(mockRepo)
+		result, err := service.Suggest("m", 10)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		mockRepo.AssertNotCalled(t, "Suggest", mock.Anything, mock.Anything)
+	})
+
+	t.Run("limits results to 20", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("Suggest", "chat", 20).Return([]tools.Suggestion{}, nil)
+
+		service := tools.NewService(mockRepo)
+		_, err := service.Suggest("chat", 50)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
 }
 
 func TestServiceGetToolBySlug(t *testing.T) {
@@ -220,3 +421,136 @@ func TestServiceGetToolByID(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestServiceCompareTools(t *testing.T) {
+	t.Run("returns tools in requested order with differs markers", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		slugs := []string{"claude", "chatgpt"}
+		found := []domain.Tool{
+			{ID: 1, Slug: "chatgpt", Name: "ChatGPT", HasFreeTier: true, Platforms: "web, mobile"},
+			{ID: 2, Slug: "claude", Name: "Claude", HasFreeTier: true, Platforms: "Mobile,Web"},
+		}
+		avgOne, avgTwo := 4.5, 4.25
+		mockRepo.On("GetToolsBySlugs", slugs).Return(found, nil)
+		mockRepo.On("GetReviewAggregates", []uint{2, 1}).Return(map[uint]tools.ReviewAggregate{
+			1: {ToolID: 1, AvgOverall: &avgOne, ReviewCount: 10},
+			2: {ToolID: 2, AvgOverall: &avgTwo, ReviewCount: 4},
+		}, nil)
+
+		service := tools.NewService(mockRepo)
+		result, err := service.CompareTools(slugs)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "claude", result.Tools[0].Slug)
+		assert.Equal(t, "chatgpt", result.Tools[1].Slug)
+
+		rows := make(map[string]tools.ComparisonRow)
+		for _, row := range result.Rows {
+			rows[row.Key] = row
+		}
+		assert.False(t, rows["has_free_tier"].Differs)
+		assert.False(t, rows["platforms"].Differs)
+		assert.True(t, rows["avg_rating_overall"].Differs)
+		assert.True(t, rows["review_count"].Differs)
+		assert.False(t, rows["avg_rating_speed"].Differs)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects fewer than two tools", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := tools.NewService(mockRepo)
+		result, err := service.CompareTools([]string{"chatgpt"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, tools.ErrCompareCount)
+	})
+
+	t.Run("rejects more than four tools", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+
+		service := tools.NewService(mockRepo)
+		result, err := service.CompareTools([]string{"a", "b", "c", "d", "e"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, tools.ErrCompareCount)
+	})
+
+	t.Run("returns ErrToolNotFound when a slug is missing", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		slugs := []string{"chatgpt", "missing"}
+		mockRepo.On("GetToolsBySlugs", slugs).Return([]domain.Tool{{ID: 1, Slug: "chatgpt"}}, nil)
+
+		service := tools.NewService(mockRepo)
+		result, err := service.CompareTools(slugs)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, tools.ErrToolNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestServiceAddToolOwner(t *testing.T) {
+	t.Run("verifies a user as tool owner", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByIDAdmin", uint(1)).Return(&domain.Tool{ID: 1}, nil)
+		mockRepo.On("UserExists", uint(7)).Return(true, nil)
+		mockRepo.On("IsOwner", uint(1), uint(7)).Return(false, nil)
+		mockRepo.On("AddOwner", mock.MatchedBy(func(o *domain.ToolOwner) bool {
+			return o.ToolID == 1 && o.UserID == 7 && o.VerifiedBy != nil && *o.VerifiedBy == 2
+		})).Return(nil)
+
+		service := tools.NewService(mockRepo)
+		owner, err := service.AddToolOwner(1, 7, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(7), owner.UserID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns ErrToolNotFound for unknown tool", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByIDAdmin", uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+		service := tools.NewService(mockRepo)
+		_, err := service.AddToolOwner(99, 7, 2)
+
+		assert.ErrorIs(t, err, tools.ErrToolNotFound)
+	})
+
+	t.Run("returns ErrUserNotFound for unknown user", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByIDAdmin", uint(1)).Return(&domain.Tool{ID: 1}, nil)
+		mockRepo.On("UserExists", uint(7)).Return(false, nil)
+
+		service := tools.NewService(mockRepo)
+		_, err := service.AddToolOwner(1, 7, 2)
+
+		assert.ErrorIs(t, err, tools.ErrUserNotFound)
+	})
+
+	t.Run("returns ErrOwnerExists for existing owner", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetToolByIDAdmin", uint(1)).Return(&domain.Tool{ID: 1}, nil)
+		mockRepo.On("UserExists", uint(7)).Return(true, nil)
+		mockRepo.On("IsOwner", uint(1), uint(7)).Return(true, nil)
+
+		service := tools.NewService(mockRepo)
+		_, err := service.AddToolOwner(1, 7, 2)
+
+		assert.ErrorIs(t, err, tools.ErrOwnerExists)
+		mockRepo.AssertNotCalled(t, "AddOwner", mock.Anything)
+	})
+}
+
+func TestServiceRemoveToolOwner(t *testing.T) {
+	t.Run("returns ErrOwnerNotFound when nothing was removed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("RemoveOwner", uint(1), uint(7)).Return(false, nil)
+
+		service := tools.NewService(mockRepo)
+		err := service.RemoveToolOwner(1, 7)
+
+		assert.ErrorIs(t, err, tools.ErrOwnerNotFound)
+	})
+}
@@ -0,0 +1,81 @@
+package trending
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/your-org/ai-tools-atlas-backend/internal/platform/responses"
+)
+
+// Handler handles HTTP requests for trending scores
+type Handler struct {
+	service Service
+}
+
+// NewHandler creates a new trending handler
+func NewHandler(service Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterAdminRoutes registers admin trending routes
+func (h *Handler) RegisterAdminRoutes(rg *gin.RouterGroup) {
+	trending := rg.Group("/trending")
+	{
+		trending.GET("", h.ListScores)
+		trending.POST("/recompute", h.Recompute)
+		trending.GET("/:slug", h.GetToolScore)
+	}
+}
+
+// ListScores handles GET /api/v1/admin/trending
+func (h *Handler) ListScores(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+
+	scores, err := h.service.ListScores(limit)
+	if err != nil {
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to compute trending scores", nil)
+		return
+	}
+
+	settings := h.service.Settings()
+	responses.List(c, scores, map[string]interface{}{
+		"last_run": h.service.LastRun(),
+		"settings": map[string]interface{}{
+			"half_life": settings.HalfLife.String(),
+			"window":    settings.Window.String(),
+			"weights":   settings.Weights,
+		},
+	})
+}
+
+// Recompute handles POST /api/v1/admin/trending/recompute
+func (h *Handler) Recompute(c *gin.Context) {
+	result, err := h.service.Recompute()
+	if err != nil {
+		if errors.Is(err, ErrRecomputeInProgress) {
+			responses.Error(c, http.StatusConflict, "RECOMPUTE_IN_PROGRESS", "A trending recompute is already running", nil)
+			return
+		}
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to recompute trending scores", nil)
+		return
+	}
+
+	responses.Success(c, result)
+}
+
+// GetToolScore handles GET /api/v1/admin/trending/:slug
+func (h *Handler) GetToolScore(c *gin.Context) {
+	score, err := h.service.GetToolScore(c.Param("slug"))
+	if err != nil {
+		if errors.Is(err, ErrToolNotFound) {
+			responses.Error(c, http.StatusNotFound, "NOT_FOUND", "Tool not found", nil)
+			return
+		}
+		responses.Error(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to compute trending score", nil)
+		return
+	}
+
+	responses.Success(c, score)
+}
@@ -0,0 +1,131 @@
+package trending_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/trending"
+)
+
+// MockService is a mock implementation of trending.Service
+type MockService struct {
+	mock.Mock
+}
+
+func (m *MockService) Recompute() (*trending.RunResult, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*trending.RunResult), args.Error(1)
+}
+
+func (m *MockService) ListScores(limit int) ([]trending.ScoreBreakdown, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]trending.ScoreBreakdown), args.Error(1)
+}
+
+func (m *MockService) GetToolScore(slug string) (*trending.ScoreBreakdown, error) {
+	args := m.Called(slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*trending.ScoreBreakdown), args.Error(1)
+}
+
+func (m *MockService) LastRun() *trending.RunResult {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*trending.RunResult)
+}
+
+func (m *MockService) Settings() trending.Settings {
+	args := m.Called()
+	return args.Get(0).(trending.Settings)
+}
+
+func setupTestRouter(mockService *MockService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := trending.NewHandler(mockService)
+	handler.RegisterAdminRoutes(router.Group("/api/v1/admin"))
+	return router
+}
+
+func TestHandlerListScores(t *testing.T) {
+	t.Run("returns scores with settings and last run", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("ListScores", 10).Return([]trending.ScoreBreakdown{{ToolID: 1, Slug: "chatgpt", Score: 12.5}}, nil)
+		mockService.On("LastRun").Return(&trending.RunResult{ToolsUpdated: 3})
+		mockService.On("Settings").Return(testSettings)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/admin/trending?limit=10", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta := response["meta"].(map[string]interface{})
+		settings := meta["settings"].(map[string]interface{})
+		assert.Equal(t, "72h0m0s", settings["half_life"])
+		assert.NotNil(t, meta["last_run"])
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestHandlerRecompute(t *testing.T) {
+	t.Run("returns run result", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("Recompute").Return(&trending.RunResult{ToolsUpdated: 5}, nil)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/admin/trending/recompute", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("returns 409 when a recompute is running", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("Recompute").Return(nil, trending.ErrRecomputeInProgress)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/admin/trending/recompute", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestHandlerGetToolScore(t *testing.T) {
+	t.Run("returns 404 for unknown tool", func(t *testing.T) {
+		mockService := new(MockService)
+		mockService.On("GetToolScore", "missing").Return(nil, trending.ErrToolNotFound)
+
+		router := setupTestRouter(mockService)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/admin/trending/missing", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,39 @@
+package trending
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// StartJob recomputes trending scores in the background every interval until ctx is cancelled.
+// The first run starts immediately so scores are fresh after a deploy.
+func StartJob(ctx context.Context, service Service, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			runJob(service)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// runJob performs one scheduled recompute, logging failures
+func runJob(service Service) {
+	result, err := service.Recompute()
+	if err != nil {
+		if !errors.Is(err, ErrRecomputeInProgress) {
+			log.Printf("Trending recompute failed: %v", err)
+		}
+		return
+	}
+	log.Printf("Trending scores updated for %d tools in %s", result.ToolsUpdated, result.FinishedAt.Sub(result.StartedAt))
+}
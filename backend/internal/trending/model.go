@@ -0,0 +1,60 @@
+package trending
+
+import "time"
+
+// Weights scales each engagement signal in the trending score
+type Weights struct {
+	Bookmark float64 `json:"bookmark"`
+	Review   float64 `json:"review"`
+	View     float64 `json:"view"`
+	ClickOut float64 `json:"click_out"`
+}
+
+// Settings controls how trending scores are computed
+type Settings struct {
+	HalfLife time.Duration // Age at which an event counts half as much
+	Window   time.Duration // Events older than this are ignored
+	Weights  Weights
+}
+
+// Signals holds a tool's time-decayed engagement counts
+type Signals struct {
+	ToolID      uint
+	Slug        string
+	Name        string
+	Bookmarks   float64
+	Reviews     float64
+	Views       float64
+	ClickOuts   float64
+	StoredScore float64 // Currently persisted tools.trending_score
+}
+
+// Component is one signal's contribution to the score
+type Component struct {
+	Decayed  float64 `json:"decayed"`  // Sum of decayed event weights
+	Weighted float64 `json:"weighted"` // Decayed multiplied by the signal weight
+}
+
+// ScoreBreakdown is a tool's trending score with the components it is built from
+type ScoreBreakdown struct {
+	ToolID      uint                 `json:"tool_id"`
+	Slug        string               `json:"slug"`
+	Name        string               `json:"name"`
+	Score       float64              `json:"score"`
+	StoredScore float64              `json:"stored_score"`
+	Components  map[string]Component `json:"components"`
+}
+
+// RunResult describes a single recompute run
+type RunResult struct {
+	StartedAt    time.Time `json:"started_at"`
+	FinishedAt   time.Time `json:"finished_at"`
+	ToolsUpdated int       `json:"tools_updated"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// ToolScore is a computed score ready to be persisted
+type ToolScore struct {
+	ToolID uint
+	Score  float64
+}
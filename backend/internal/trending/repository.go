@@ -0,0 +1,105 @@
+package trending
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// updateBatchSize limits the number of rows written per UPDATE statement
+const updateBatchSize = 500
+
+// decayExpr weighs an event by its age: 1 when new, 0.5 after one half-life
+const decayExpr = "EXP(-LN(2) * EXTRACT(EPOCH FROM (CAST(@now AS timestamp) - created_at))::float8 / @half_life)"
+
+// Repository defines the interface for trending data operations
+type Repository interface {
+	GetSignals(since, now time.Time, halfLife time.Duration, slug string) ([]Signals, error)
+	UpdateScores(scores []ToolScore) error
+}
+
+// repository implements the Repository interface
+type repository struct {
+	db *gorm.DB
+}
+
+// NewRepository creates a new trending repository
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// GetSignals returns decayed bookmark, review, view and click-out counts since the given time
+// for every non-archived tool, or only the tool with the given slug
+func (r *repository) GetSignals(since, now time.Time, halfLife time.Duration, slug string) ([]Signals, error) {
+	query := `
+		SELECT t.id AS tool_id, t.slug, t.name, t.trending_score AS stored_score,
+			COALESCE(b.decayed, 0) AS bookmarks,
+			COALESCE(r.decayed, 0) AS reviews,
+			COALESCE(e.views, 0) AS views,
+			COALESCE(e.click_outs, 0) AS click_outs
+		FROM tools t
+		LEFT JOIN (
+			SELECT tool_id, SUM(` + decayExpr + `) AS decayed
+			FROM bookmarks
+			WHERE created_at >= @since
+			GROUP BY tool_id
+		) b ON b.tool_id = t.id
+		LEFT JOIN (
+			SELECT tool_id, SUM(` + decayExpr + `) AS decayed
+			FROM reviews
+			WHERE created_at >= @since AND moderation_status = 'approved'
+			GROUP BY tool_id
+		) r ON r.tool_id = t.id
+		LEFT JOIN (
+			SELECT tool_id,
+				SUM(` + decayExpr + `) FILTER (WHERE event_type = 'view') AS views,
+				SUM(` + decayExpr + `) FILTER (WHERE event_type = 'click_out') AS click_outs
+			FROM tool_events
+			WHERE created_at >= @since
+			GROUP BY tool_id
+		) e ON e.tool_id = t.id
+		WHERE t.archived_at IS NULL`
+	if slug != "" {
+		query += " AND t.slug = @slug"
+	}
+
+	var signals []Signals
+	err := r.db.Raw(query, map[string]interface{}{
+		"since":     since,
+		"now":       now,
+		"half_life": halfLife.Seconds(),
+		"slug":      slug,
+	}).Scan(&signals).Error
+	if err != nil {
+		return nil, err
+	}
+	return signals, nil
+}
+
+// UpdateScores writes trending scores in batches within a single transaction
+func (r *repository) UpdateScores(scores []ToolScore) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(scores); start += updateBatchSize {
+			end := start + updateBatchSize
+			if end > len(scores) {
+				end = len(scores)
+			}
+
+			rows := make([]string, 0, end-start)
+			args := make([]interface{}, 0, 2*(end-start))
+			for _, s := range scores[start:end] {
+				rows = append(rows, "(?::int, ?::numeric)")
+				args = append(args, s.ToolID, s.Score)
+			}
+
+			err := tx.Exec(`UPDATE tools SET trending_score = v.score
+				FROM (VALUES `+strings.Join(rows, ", ")+`) AS v(id, score)
+				WHERE tools.id = v.id`, args...).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,166 @@
+package trending
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrToolNotFound        = errors.New("tool not found")
+	ErrRecomputeInProgress = errors.New("trending recompute already in progress")
+)
+
+// Service defines the interface for trending score business logic
+type Service interface {
+	Recompute() (*RunResult, error)
+	ListScores(limit int) ([]ScoreBreakdown, error)
+	GetToolScore(slug string) (*ScoreBreakdown, error)
+	LastRun() *RunResult
+	Settings() Settings
+}
+
+// service implements the Service interface
+type service struct {
+	repo     Repository
+	settings Settings
+
+	running sync.Mutex // Held for the duration of a recompute
+
+	mu      sync.RWMutex
+	lastRun *RunResult
+}
+
+// NewService creates a new trending service
+func NewService(repo Repository, settings Settings) Service {
+	return &service{repo: repo, settings: settings}
+}
+
+// Recompute recalculates and persists the trending score of every tool.
+// Only one recompute runs at a time; concurrent calls return ErrRecomputeInProgress.
+func (s *service) Recompute() (*RunResult, error) {
+	if !s.running.TryLock() {
+		return nil, ErrRecomputeInProgress
+	}
+	defer s.running.Unlock()
+
+	result := &RunResult{StartedAt: time.Now()}
+	err := s.recompute(result)
+	result.FinishedAt = time.Now()
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	s.mu.Lock()
+	s.lastRun = result
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// recompute loads signals, scores them and writes the scores back
+func (s *service) recompute(result *RunResult) error {
+	signals, err := s.repo.GetSignals(result.StartedAt.Add(-s.settings.Window), result.StartedAt, s.settings.HalfLife, "")
+	if err != nil {
+		return err
+	}
+
+	scores := make([]ToolScore, len(signals))
+	for i, sig := range signals {
+		scores[i] = ToolScore{ToolID: sig.ToolID, Score: Score(sig, s.settings.Weights).Score}
+	}
+
+	if err := s.repo.UpdateScores(scores); err != nil {
+		return err
+	}
+
+	result.ToolsUpdated = len(scores)
+	return nil
+}
+
+// ListScores returns live score breakdowns for the highest-scoring tools
+func (s *service) ListScores(limit int) ([]ScoreBreakdown, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	now := time.Now()
+	signals, err := s.repo.GetSignals(now.Add(-s.settings.Window), now, s.settings.HalfLife, "")
+	if err != nil {
+		return nil, err
+	}
+
+	breakdowns := make([]ScoreBreakdown, len(signals))
+	for i, sig := range signals {
+		breakdowns[i] = Score(sig, s.settings.Weights)
+	}
+	sort.SliceStable(breakdowns, func(i, j int) bool {
+		return breakdowns[i].Score > breakdowns[j].Score
+	})
+
+	if len(breakdowns) > limit {
+		breakdowns = breakdowns[:limit]
+	}
+	return breakdowns, nil
+}
+
+// GetToolScore returns the live score breakdown for a single tool
+func (s *service) GetToolScore(slug string) (*ScoreBreakdown, error) {
+	now := time.Now()
+	signals, err := s.repo.GetSignals(now.Add(-s.settings.Window), now, s.settings.HalfLife, slug)
+	if err != nil {
+		return nil, err
+	}
+	if len(signals) == 0 {
+		return nil, ErrToolNotFound
+	}
+
+	breakdown := Score(signals[0], s.settings.Weights)
+	return &breakdown, nil
+}
+
+// LastRun returns the result of the most recent recompute, or nil if none has run
+func (s *service) LastRun() *RunResult {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastRun
+}
+
+// Settings returns the active trending settings
+func (s *service) Settings() Settings {
+	return s.settings
+}
+
+// Score combines a tool's decayed signals into its weighted trending score
+func Score(sig Signals, w Weights) ScoreBreakdown {
+	components := map[string]Component{
+		"bookmarks":  {Decayed: round(sig.Bookmarks), Weighted: round(sig.Bookmarks * w.Bookmark)},
+		"reviews":    {Decayed: round(sig.Reviews), Weighted: round(sig.Reviews * w.Review)},
+		"views":      {Decayed: round(sig.Views), Weighted: round(sig.Views * w.View)},
+		"click_outs": {Decayed: round(sig.ClickOuts), Weighted: round(sig.ClickOuts * w.ClickOut)},
+	}
+
+	total := sig.Bookmarks*w.Bookmark + sig.Reviews*w.Review + sig.Views*w.View + sig.ClickOuts*w.ClickOut
+
+	return ScoreBreakdown{
+		ToolID:      sig.ToolID,
+		Slug:        sig.Slug,
+		Name:        sig.Name,
+		Score:       round(total),
+		StoredScore: sig.StoredScore,
+		Components:  components,
+	}
+}
+
+// round rounds to two decimals, matching the precision of tools.trending_score
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
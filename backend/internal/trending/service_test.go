@@ -0,0 +1,145 @@
+package trending_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/your-org/ai-tools-atlas-backend/internal/trending"
+)
+
+// MockRepository is a mock implementation of trending.Repository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetSignals(since, now time.Time, halfLife time.Duration, slug string) ([]trending.Signals, error) {
+	args := m.Called(since, now, halfLife, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]trending.Signals), args.Error(1)
+}
+
+func (m *MockRepository) UpdateScores(scores []trending.ToolScore) error {
+	args := m.Called(scores)
+	return args.Error(0)
+}
+
+var testSettings = trending.Settings{
+	HalfLife: 72 * time.Hour,
+	Window:   30 * 24 * time.Hour,
+	Weights:  trending.Weights{Bookmark: 3, Review: 5, View: 0.1, ClickOut: 1},
+}
+
+func TestScore(t *testing.T) {
+	t.Run("weights each decayed signal", func(t *testing.T) {
+		sig := trending.Signals{ToolID: 1, Slug: "chatgpt", Bookmarks: 2, Reviews: 1, Views: 30, ClickOuts: 4.5, StoredScore: 7}
+
+		result := trending.Score(sig, testSettings.Weights)
+
+		// 2*3 + 1*5 + 30*0.1 + 4.5*1
+		assert.Equal(t, 18.5, result.Score)
+		assert.Equal(t, 7.0, result.StoredScore)
+		assert.Equal(t, trending.Component{Decayed: 2, Weighted: 6}, result.Components["bookmarks"])
+		assert.Equal(t, trending.Component{Decayed: 30, Weighted: 3}, result.Components["views"])
+	})
+
+	t.Run("is zero without engagement", func(t *testing.T) {
+		result := trending.Score(trending.Signals{ToolID: 1}, testSettings.Weights)
+
+		assert.Equal(t, 0.0, result.Score)
+	})
+}
+
+func TestServiceRecompute(t *testing.T) {
+	t.Run("persists scores for every tool", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		signals := []trending.Signals{
+			{ToolID: 1, Bookmarks: 1},
+			{ToolID: 2},
+		}
+		mockRepo.On("GetSignals", mock.Anything, mock.Anything, testSettings.HalfLife, "").Return(signals, nil)
+		mockRepo.On("UpdateScores", []trending.ToolScore{{ToolID: 1, Score: 3}, {ToolID: 2, Score: 0}}).Return(nil)
+
+		service := trending.NewService(mockRepo, testSettings)
+		result, err := service.Recompute()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.ToolsUpdated)
+		assert.Equal(t, result, service.LastRun())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("uses the configured window", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetSignals", mock.Anything, mock.Anything, testSettings.HalfLife, "").Return([]trending.Signals{}, nil)
+		mockRepo.On("UpdateScores", []trending.ToolScore{}).Return(nil)
+
+		service := trending.NewService(mockRepo, testSettings)
+		_, err := service.Recompute()
+
+		assert.NoError(t, err)
+		call := mockRepo.Calls[0]
+		since, now := call.Arguments.Get(0).(time.Time), call.Arguments.Get(1).(time.Time)
+		assert.Equal(t, testSettings.Window, now.Sub(since))
+	})
+
+	t.Run("records failed runs", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetSignals", mock.Anything, mock.Anything, testSettings.HalfLife, "").Return(nil, assert.AnError)
+
+		service := trending.NewService(mockRepo, testSettings)
+		result, err := service.Recompute()
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+		assert.Equal(t, assert.AnError.Error(), service.LastRun().Error)
+		mockRepo.AssertNotCalled(t, "UpdateScores", mock.Anything)
+	})
+}
+
+func TestServiceListScores(t *testing.T) {
+	t.Run("orders by score and applies limit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		signals := []trending.Signals{
+			{ToolID: 1, Slug: "low", Views: 10},
+			{ToolID: 2, Slug: "high", Reviews: 2},
+			{ToolID: 3, Slug: "mid", Bookmarks: 1},
+		}
+		mockRepo.On("GetSignals", mock.Anything, mock.Anything, testSettings.HalfLife, "").Return(signals, nil)
+
+		service := trending.NewService(mockRepo, testSettings)
+		result, err := service.ListScores(2)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "high", result[0].Slug)
+		assert.Equal(t, "mid", result[1].Slug)
+	})
+}
+
+func TestServiceGetToolScore(t *testing.T) {
+	t.Run("returns breakdown for tool", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetSignals", mock.Anything, mock.Anything, testSettings.HalfLife, "chatgpt").
+			Return([]trending.Signals{{ToolID: 1, Slug: "chatgpt", ClickOuts: 2}}, nil)
+
+		service := trending.NewService(mockRepo, testSettings)
+		result, err := service.GetToolScore("chatgpt")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, result.Score)
+	})
+
+	t.Run("returns error for unknown tool", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetSignals", mock.Anything, mock.Anything, testSettings.HalfLife, "missing").Return([]trending.Signals{}, nil)
+
+		service := trending.NewService(mockRepo, testSettings)
+		_, err := service.GetToolScore("missing")
+
+		assert.ErrorIs(t, err, trending.ErrToolNotFound)
+	})
+}
@@ -0,0 +1,5 @@
+-- Rollback full-text search
+DROP INDEX IF EXISTS idx_tools_search_vector;
+DROP TRIGGER IF EXISTS trg_tools_search_vector ON tools;
+DROP FUNCTION IF EXISTS tools_search_vector_update();
+ALTER TABLE tools DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,30 @@
+-- Weighted full-text search vector for tools
+-- Weights: name (A) > tagline (B) > best_for / primary_use_cases (C) > description (D)
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+CREATE OR REPLACE FUNCTION tools_search_vector_update() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', coalesce(NEW.name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(NEW.tagline, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(NEW.best_for, '') || ' ' || coalesce(NEW.primary_use_cases, '')), 'C') ||
+        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'D');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_tools_search_vector ON tools;
+CREATE TRIGGER trg_tools_search_vector
+    BEFORE INSERT OR UPDATE OF name, tagline, best_for, primary_use_cases, description
+    ON tools
+    FOR EACH ROW
+    EXECUTE FUNCTION tools_search_vector_update();
+
+-- Backfill existing rows
+UPDATE tools SET search_vector =
+    setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(tagline, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(best_for, '') || ' ' || coalesce(primary_use_cases, '')), 'C') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'D');
+
+CREATE INDEX IF NOT EXISTS idx_tools_search_vector ON tools USING GIN (search_vector);
@@ -0,0 +1,5 @@
+-- Rollback trigram indexes (extension is left installed as other objects may use it)
+DROP INDEX IF EXISTS idx_tags_name_trgm;
+DROP INDEX IF EXISTS idx_categories_name_trgm;
+DROP INDEX IF EXISTS idx_tools_tagline_trgm;
+DROP INDEX IF EXISTS idx_tools_name_trgm;
@@ -0,0 +1,7 @@
+-- Trigram matching for typo-tolerant search and autocomplete
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_tools_name_trgm ON tools USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tools_tagline_trgm ON tools USING GIN (tagline gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_categories_name_trgm ON categories USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tags_name_trgm ON tags USING GIN (name gin_trgm_ops);
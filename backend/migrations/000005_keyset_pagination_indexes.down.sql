@@ -0,0 +1,6 @@
+-- Rollback keyset pagination indexes
+DROP INDEX IF EXISTS idx_reviews_keyset_most_helpful;
+DROP INDEX IF EXISTS idx_reviews_keyset_newest;
+DROP INDEX IF EXISTS idx_tools_keyset_category;
+DROP INDEX IF EXISTS idx_tools_keyset_newest;
+DROP INDEX IF EXISTS idx_tools_keyset_top_rated;
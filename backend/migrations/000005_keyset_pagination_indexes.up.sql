@@ -0,0 +1,7 @@
+-- Composite indexes matching the keyset pagination sort orders (sort keys, then id)
+CREATE INDEX IF NOT EXISTS idx_tools_keyset_top_rated ON tools (avg_rating_overall DESC, review_count DESC, id) WHERE archived_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tools_keyset_newest ON tools (created_at DESC, id) WHERE archived_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tools_keyset_category ON tools (primary_category_id, avg_rating_overall DESC, review_count DESC, id) WHERE archived_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_reviews_keyset_newest ON reviews (tool_id, created_at DESC, id) WHERE moderation_status = 'approved';
+CREATE INDEX IF NOT EXISTS idx_reviews_keyset_most_helpful ON reviews (tool_id, helpful_count DESC, created_at DESC, id) WHERE moderation_status = 'approved';
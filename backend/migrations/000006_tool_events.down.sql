@@ -0,0 +1,4 @@
+-- Rollback tool events
+DROP INDEX IF EXISTS idx_reviews_created_at;
+DROP INDEX IF EXISTS idx_bookmarks_created_at;
+DROP TABLE IF EXISTS tool_events;
@@ -0,0 +1,19 @@
+-- Append-only engagement events (profile views and click-outs), used for trending scores
+CREATE TABLE IF NOT EXISTS tool_events (
+    id BIGSERIAL PRIMARY KEY,
+    tool_id INT NOT NULL,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('view', 'click_out')),
+    user_id INT,
+    session_id VARCHAR(255),
+    referrer TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (tool_id) REFERENCES tools(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_tool_events_tool_type_created ON tool_events(tool_id, event_type, created_at);
+CREATE INDEX IF NOT EXISTS idx_tool_events_created_at ON tool_events(created_at);
+
+-- Recency lookups for the trending window
+CREATE INDEX IF NOT EXISTS idx_bookmarks_created_at ON bookmarks(created_at);
+CREATE INDEX IF NOT EXISTS idx_reviews_created_at ON reviews(created_at);
@@ -0,0 +1,3 @@
+-- Rollback review votes
+ALTER TABLE reviews DROP COLUMN IF EXISTS not_helpful_count;
+DROP TABLE IF EXISTS review_votes;
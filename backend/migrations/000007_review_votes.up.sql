@@ -0,0 +1,18 @@
+-- Helpfulness votes on reviews, one per user or anonymous session
+CREATE TABLE IF NOT EXISTS review_votes (
+    id SERIAL PRIMARY KEY,
+    review_id INT NOT NULL,
+    user_id INT,
+    session_id VARCHAR(255),
+    helpful BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (user_id IS NOT NULL OR session_id IS NOT NULL),
+    FOREIGN KEY (review_id) REFERENCES reviews(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_review_votes_review_user ON review_votes(review_id, user_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_review_votes_review_session ON review_votes(review_id, session_id) WHERE session_id IS NOT NULL;
+
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS not_helpful_count INT NOT NULL DEFAULT 0;
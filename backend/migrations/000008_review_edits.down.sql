@@ -0,0 +1,4 @@
+-- Rollback review edits
+ALTER TABLE reviews DROP COLUMN IF EXISTS edit_count;
+ALTER TABLE reviews DROP COLUMN IF EXISTS edited_at;
+DROP TABLE IF EXISTS review_edits;
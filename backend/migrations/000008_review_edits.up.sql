@@ -0,0 +1,27 @@
+-- Edit history for reviews: each row is the review as it was before an edit
+CREATE TABLE IF NOT EXISTS review_edits (
+    id SERIAL PRIMARY KEY,
+    review_id INT NOT NULL,
+    user_id INT NOT NULL,
+    rating_overall INT NOT NULL,
+    rating_ease_of_use INT,
+    rating_value INT,
+    rating_accuracy INT,
+    rating_speed INT,
+    rating_support INT,
+    pros TEXT,
+    cons TEXT,
+    primary_use_case VARCHAR(255),
+    reviewer_role VARCHAR(255),
+    company_size VARCHAR(100),
+    usage_context TEXT,
+    moderation_status VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (review_id) REFERENCES reviews(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_edits_review_id ON review_edits(review_id, created_at);
+
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS edit_count INT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+-- Rollback review pre-moderation
+DELETE FROM reports WHERE reason = 'pre_moderation';
+ALTER TABLE reports DROP CONSTRAINT IF EXISTS reports_reason_check;
+ALTER TABLE reports ADD CONSTRAINT reports_reason_check
+    CHECK (reason IN ('spam', 'abuse', 'misinformation', 'other'));
@@ -0,0 +1,4 @@
+-- Reviews held by the pre-moderation pipeline enter the moderation queue as system reports
+ALTER TABLE reports DROP CONSTRAINT IF EXISTS reports_reason_check;
+ALTER TABLE reports ADD CONSTRAINT reports_reason_check
+    CHECK (reason IN ('spam', 'abuse', 'misinformation', 'other', 'pre_moderation'));
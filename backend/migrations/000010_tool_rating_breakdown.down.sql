@@ -0,0 +1,11 @@
+-- Rollback tool rating breakdown
+ALTER TABLE tools DROP COLUMN IF EXISTS rating_5_count;
+ALTER TABLE tools DROP COLUMN IF EXISTS rating_4_count;
+ALTER TABLE tools DROP COLUMN IF EXISTS rating_3_count;
+ALTER TABLE tools DROP COLUMN IF EXISTS rating_2_count;
+ALTER TABLE tools DROP COLUMN IF EXISTS rating_1_count;
+ALTER TABLE tools DROP COLUMN IF EXISTS avg_rating_support;
+ALTER TABLE tools DROP COLUMN IF EXISTS avg_rating_speed;
+ALTER TABLE tools DROP COLUMN IF EXISTS avg_rating_accuracy;
+ALTER TABLE tools DROP COLUMN IF EXISTS avg_rating_value;
+ALTER TABLE tools DROP COLUMN IF EXISTS avg_rating_ease_of_use;
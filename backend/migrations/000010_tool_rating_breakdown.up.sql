@@ -0,0 +1,41 @@
+-- Per-dimension rating averages and a star histogram stored on tools
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS avg_rating_ease_of_use DECIMAL(3,2);
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS avg_rating_value DECIMAL(3,2);
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS avg_rating_accuracy DECIMAL(3,2);
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS avg_rating_speed DECIMAL(3,2);
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS avg_rating_support DECIMAL(3,2);
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS rating_1_count INT NOT NULL DEFAULT 0;
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS rating_2_count INT NOT NULL DEFAULT 0;
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS rating_3_count INT NOT NULL DEFAULT 0;
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS rating_4_count INT NOT NULL DEFAULT 0;
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS rating_5_count INT NOT NULL DEFAULT 0;
+
+-- Backfill from existing approved reviews
+UPDATE tools SET
+    avg_rating_ease_of_use = agg.avg_ease_of_use,
+    avg_rating_value = agg.avg_value,
+    avg_rating_accuracy = agg.avg_accuracy,
+    avg_rating_speed = agg.avg_speed,
+    avg_rating_support = agg.avg_support,
+    rating_1_count = agg.rating_1,
+    rating_2_count = agg.rating_2,
+    rating_3_count = agg.rating_3,
+    rating_4_count = agg.rating_4,
+    rating_5_count = agg.rating_5
+FROM (
+    SELECT tool_id,
+        AVG(rating_ease_of_use) AS avg_ease_of_use,
+        AVG(rating_value) AS avg_value,
+        AVG(rating_accuracy) AS avg_accuracy,
+        AVG(rating_speed) AS avg_speed,
+        AVG(rating_support) AS avg_support,
+        COUNT(*) FILTER (WHERE rating_overall = 1) AS rating_1,
+        COUNT(*) FILTER (WHERE rating_overall = 2) AS rating_2,
+        COUNT(*) FILTER (WHERE rating_overall = 3) AS rating_3,
+        COUNT(*) FILTER (WHERE rating_overall = 4) AS rating_4,
+        COUNT(*) FILTER (WHERE rating_overall = 5) AS rating_5
+    FROM reviews
+    WHERE moderation_status = 'approved'
+    GROUP BY tool_id
+) agg
+WHERE tools.id = agg.tool_id;
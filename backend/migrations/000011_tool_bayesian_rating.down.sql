@@ -0,0 +1,7 @@
+-- Rollback Bayesian rating
+DROP INDEX IF EXISTS idx_tools_keyset_top_rated;
+DROP INDEX IF EXISTS idx_tools_keyset_category;
+CREATE INDEX IF NOT EXISTS idx_tools_keyset_top_rated ON tools (avg_rating_overall DESC, review_count DESC, id) WHERE archived_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tools_keyset_category ON tools (primary_category_id, avg_rating_overall DESC, review_count DESC, id) WHERE archived_at IS NULL;
+
+ALTER TABLE tools DROP COLUMN IF EXISTS bayesian_rating;
@@ -0,0 +1,21 @@
+-- Bayesian-adjusted rating used by the top_rated sort
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS bayesian_rating DECIMAL(6,4) NOT NULL DEFAULT 0;
+
+-- Backfill with the default confidence weight (10); the score is recomputed on every rating change
+WITH prior AS (
+    SELECT COALESCE(AVG(rating_overall), 0) AS mean FROM reviews WHERE moderation_status = 'approved'
+), stats AS (
+    SELECT tool_id, SUM(rating_overall) AS total, COUNT(*) AS n
+    FROM reviews
+    WHERE moderation_status = 'approved'
+    GROUP BY tool_id
+)
+UPDATE tools SET bayesian_rating = ROUND((10 * prior.mean + stats.total) / (10 + stats.n), 4)
+FROM stats, prior
+WHERE tools.id = stats.tool_id;
+
+-- Keyset indexes for the top_rated sort now lead with the Bayesian score
+DROP INDEX IF EXISTS idx_tools_keyset_top_rated;
+DROP INDEX IF EXISTS idx_tools_keyset_category;
+CREATE INDEX IF NOT EXISTS idx_tools_keyset_top_rated ON tools (bayesian_rating DESC, review_count DESC, id) WHERE archived_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tools_keyset_category ON tools (primary_category_id, bayesian_rating DESC, review_count DESC, id) WHERE archived_at IS NULL;
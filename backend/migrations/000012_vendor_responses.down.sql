@@ -0,0 +1,4 @@
+-- Rollback vendor responses
+ALTER TABLE moderation_actions DROP COLUMN IF EXISTS vendor_response_id;
+DROP TABLE IF EXISTS vendor_responses;
+DROP TABLE IF EXISTS tool_owners;
@@ -0,0 +1,38 @@
+-- Users verified by an admin as representatives of a tool's vendor
+CREATE TABLE IF NOT EXISTS tool_owners (
+    id SERIAL PRIMARY KEY,
+    tool_id INT NOT NULL,
+    user_id INT NOT NULL,
+    verified_by INT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (tool_id) REFERENCES tools(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (verified_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tool_owners_tool_user ON tool_owners(tool_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_tool_owners_user_id ON tool_owners(user_id);
+
+-- A tool owner's public reply to a review; at most one per review
+CREATE TABLE IF NOT EXISTS vendor_responses (
+    id SERIAL PRIMARY KEY,
+    review_id INT NOT NULL,
+    tool_id INT NOT NULL,
+    user_id INT NOT NULL,
+    body TEXT NOT NULL,
+    moderation_status VARCHAR(50) NOT NULL DEFAULT 'pending' CHECK (moderation_status IN ('pending', 'approved', 'rejected', 'hidden', 'removed')),
+    moderated_by INT,
+    moderated_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (review_id) REFERENCES reviews(id) ON DELETE CASCADE,
+    FOREIGN KEY (tool_id) REFERENCES tools(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (moderated_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vendor_responses_review_id ON vendor_responses(review_id);
+CREATE INDEX IF NOT EXISTS idx_vendor_responses_status ON vendor_responses(moderation_status, created_at);
+
+-- Moderation actions on a vendor response are logged against its review
+ALTER TABLE moderation_actions ADD COLUMN IF NOT EXISTS vendor_response_id INT REFERENCES vendor_responses(id) ON DELETE CASCADE;
@@ -0,0 +1,14 @@
+-- Rollback review state machine
+DROP INDEX IF EXISTS idx_moderation_actions_review_created;
+ALTER TABLE moderation_actions DROP COLUMN IF EXISTS to_status;
+ALTER TABLE moderation_actions DROP COLUMN IF EXISTS from_status;
+
+DELETE FROM moderation_actions WHERE action_type = 'reject';
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_type_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_type_check
+    CHECK (action_type IN ('approve', 'hide', 'remove', 'restore'));
+
+UPDATE reviews SET moderation_status = 'rejected' WHERE moderation_status IN ('hidden', 'removed');
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_moderation_status_check;
+ALTER TABLE reviews ADD CONSTRAINT reviews_moderation_status_check
+    CHECK (moderation_status IN ('pending', 'approved', 'rejected'));
@@ -0,0 +1,15 @@
+-- Reviews can be hidden or removed by moderators
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_moderation_status_check;
+ALTER TABLE reviews ADD CONSTRAINT reviews_moderation_status_check
+    CHECK (moderation_status IN ('pending', 'approved', 'rejected', 'hidden', 'removed'));
+
+-- Moderators can reject pending reviews
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_type_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_type_check
+    CHECK (action_type IN ('approve', 'reject', 'hide', 'remove', 'restore'));
+
+-- Each action records the transition it made so it can be restored
+ALTER TABLE moderation_actions ADD COLUMN IF NOT EXISTS from_status VARCHAR(50);
+ALTER TABLE moderation_actions ADD COLUMN IF NOT EXISTS to_status VARCHAR(50);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_actions_review_created ON moderation_actions(review_id, created_at DESC);
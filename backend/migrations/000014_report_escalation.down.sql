@@ -0,0 +1,14 @@
+-- Rollback report escalation
+DELETE FROM moderation_actions WHERE review_id IS NULL OR moderator_id IS NULL;
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_type_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_type_check
+    CHECK (action_type IN ('approve', 'reject', 'hide', 'remove', 'restore'));
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_target_check;
+ALTER TABLE moderation_actions DROP COLUMN IF EXISTS tool_id;
+ALTER TABLE moderation_actions ALTER COLUMN review_id SET NOT NULL;
+ALTER TABLE moderation_actions ALTER COLUMN moderator_id SET NOT NULL;
+
+ALTER TABLE tools DROP COLUMN IF EXISTS flagged_at;
+
+DROP INDEX IF EXISTS idx_reports_item_pending;
+ALTER TABLE reports DROP COLUMN IF EXISTS escalated_at;
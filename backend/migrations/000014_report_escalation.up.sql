@@ -0,0 +1,17 @@
+-- Reports on an item that crossed an escalation threshold are shown first in the queue
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMP;
+CREATE INDEX IF NOT EXISTS idx_reports_item_pending ON reports(reportable_type, reportable_id, created_at) WHERE status = 'pending';
+
+-- Tools flagged for moderator attention by report escalation
+ALTER TABLE tools ADD COLUMN IF NOT EXISTS flagged_at TIMESTAMP;
+
+-- System moderation actions have no moderator, and tool flags have no review
+ALTER TABLE moderation_actions ALTER COLUMN moderator_id DROP NOT NULL;
+ALTER TABLE moderation_actions ALTER COLUMN review_id DROP NOT NULL;
+ALTER TABLE moderation_actions ADD COLUMN IF NOT EXISTS tool_id INT REFERENCES tools(id) ON DELETE CASCADE;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_target_check
+    CHECK (review_id IS NOT NULL OR tool_id IS NOT NULL);
+
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_type_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_type_check
+    CHECK (action_type IN ('approve', 'reject', 'hide', 'remove', 'restore', 'flag'));
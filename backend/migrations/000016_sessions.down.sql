@@ -0,0 +1,4 @@
+-- Rollback sessions
+DROP INDEX IF EXISTS idx_sessions_previous_token_hash;
+DROP INDEX IF EXISTS idx_sessions_user_active;
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,5 @@
+-- Rollback login attempts
+DROP INDEX IF EXISTS idx_login_attempts_user_created;
+DROP INDEX IF EXISTS idx_login_attempts_ip_created;
+DROP INDEX IF EXISTS idx_login_attempts_email_created;
+DROP TABLE IF EXISTS login_attempts;
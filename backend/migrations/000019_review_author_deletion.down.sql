@@ -0,0 +1,13 @@
+-- Rollback review author deletion. Deleted reviews are kept as removed, and their deletions stay
+-- in the moderation history as removals, so no reviews or audit entries are lost.
+UPDATE moderation_actions SET action_type = 'remove' WHERE action_type = 'delete';
+UPDATE moderation_actions SET to_status = 'removed' WHERE to_status = 'deleted';
+UPDATE moderation_actions SET from_status = 'removed' WHERE from_status = 'deleted';
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_type_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_type_check
+    CHECK (action_type IN ('approve', 'reject', 'hide', 'remove', 'restore', 'flag'));
+
+UPDATE reviews SET moderation_status = 'removed' WHERE moderation_status = 'deleted';
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_moderation_status_check;
+ALTER TABLE reviews ADD CONSTRAINT reviews_moderation_status_check
+    CHECK (moderation_status IN ('pending', 'approved', 'rejected', 'hidden', 'removed'));
@@ -0,0 +1,9 @@
+-- Authors delete reviews by moving them to 'deleted', so moderation history and reports are kept
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_moderation_status_check;
+ALTER TABLE reviews ADD CONSTRAINT reviews_moderation_status_check
+    CHECK (moderation_status IN ('pending', 'approved', 'rejected', 'hidden', 'removed', 'deleted'));
+
+-- The deletion is logged with the author as the acting user
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_type_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_type_check
+    CHECK (action_type IN ('approve', 'reject', 'hide', 'remove', 'restore', 'flag', 'delete'));
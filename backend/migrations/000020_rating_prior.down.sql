@@ -0,0 +1,2 @@
+-- Rollback rating prior
+DROP TABLE IF EXISTS rating_prior;
@@ -0,0 +1,11 @@
+-- Catalog-wide mean rating used as the Bayesian prior. Review writes rescore only their own tool
+-- against this stored mean; the scheduled job refreshes it and rescores every tool.
+CREATE TABLE IF NOT EXISTS rating_prior (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    mean DECIMAL(6,4) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO rating_prior (id, mean)
+SELECT TRUE, COALESCE(AVG(rating_overall), 0) FROM reviews WHERE moderation_status = 'approved'
+ON CONFLICT (id) DO NOTHING;
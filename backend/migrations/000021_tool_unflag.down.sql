@@ -0,0 +1,5 @@
+-- Rollback tool unflag actions
+DELETE FROM moderation_actions WHERE action_type = 'unflag';
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_type_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_type_check
+    CHECK (action_type IN ('approve', 'reject', 'hide', 'remove', 'restore', 'flag', 'delete'));
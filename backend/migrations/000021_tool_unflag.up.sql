@@ -0,0 +1,4 @@
+-- Moderators clear escalation flags on tools once they have looked at them
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_type_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_type_check
+    CHECK (action_type IN ('approve', 'reject', 'hide', 'remove', 'restore', 'flag', 'delete', 'unflag'));
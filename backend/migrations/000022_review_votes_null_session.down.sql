@@ -0,0 +1,2 @@
+-- Nothing to roll back: a NULL session_id on a user's vote is valid under the previous schema
+SELECT 1;
@@ -0,0 +1,3 @@
+-- Votes from logged-in users were stored with an empty session_id, which the partial unique
+-- index on (review_id, session_id) treats as a real session
+UPDATE review_votes SET session_id = NULL WHERE user_id IS NOT NULL AND session_id = '';
@@ -0,0 +1,6 @@
+-- Rollback login attempt reservation. Attempts still pending never finished, so they are kept
+-- as failed logins, which is how the throttle counted them.
+UPDATE login_attempts SET failure_reason = 'invalid_credentials' WHERE failure_reason = 'pending';
+ALTER TABLE login_attempts DROP CONSTRAINT IF EXISTS login_attempts_failure_reason_check;
+ALTER TABLE login_attempts ADD CONSTRAINT login_attempts_failure_reason_check
+    CHECK (failure_reason IN ('invalid_credentials', 'suspended', 'throttled'));
@@ -0,0 +1,5 @@
+-- Login attempts are recorded as pending before the password is checked, so concurrent attempts
+-- each count the ones reserved before them towards the throttle
+ALTER TABLE login_attempts DROP CONSTRAINT IF EXISTS login_attempts_failure_reason_check;
+ALTER TABLE login_attempts ADD CONSTRAINT login_attempts_failure_reason_check
+    CHECK (failure_reason IN ('invalid_credentials', 'suspended', 'throttled', 'pending'));